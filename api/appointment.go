@@ -0,0 +1,407 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	db "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/sqlc"
+	"github.com/gin-gonic/gin"
+)
+
+// swagger:model AppointmentResponse
+type AppointmentResponse struct {
+	// The ID of the Appointment
+	// example: 1
+	ID int32 `json:"id"`
+	// The ID of the Customer who booked
+	// example: 1
+	CustomerID int64 `json:"customer_id"`
+	// The ID of the Car to service
+	// example: 1
+	CarID int32 `json:"car_id"`
+	// The bay booked
+	// example: 1
+	Bay int32 `json:"bay"`
+	// When the Car is expected
+	// example: 2022-06-01T09:00:00Z
+	StartsAt time.Time `json:"starts_at"`
+	// When the Services requested should be done, from their estimation times
+	// example: 2022-06-01T10:30:00Z
+	EndsAt time.Time `json:"ends_at"`
+	// What the customer said on the phone
+	// example: brakes are noisy
+	Notes string `json:"notes"`
+	// The state of the Appointment
+	// example: booked
+	State string `json:"state"`
+	// The ID of the Service Order opened when the Car arrived
+	// example: 1
+	ServiceOrderID int32 `json:"service_order_id,omitempty"`
+	// The Services requested
+	Services []ServiceResponse `json:"services"`
+}
+
+func newAppointmentResponse(appointment db.Appointment, services []db.Service) AppointmentResponse {
+	rsp := AppointmentResponse{
+		ID:             appointment.ID,
+		CustomerID:     appointment.CustomerID,
+		CarID:          appointment.CarID,
+		Bay:            appointment.Bay,
+		StartsAt:       appointment.StartsAt,
+		EndsAt:         appointment.EndsAt,
+		Notes:          appointment.Notes,
+		State:          db.AppointmentStateName(appointment.State),
+		ServiceOrderID: appointment.ServiceOrderID.Int32,
+		Services:       make([]ServiceResponse, 0, len(services)),
+	}
+	for _, service := range services {
+		rsp.Services = append(rsp.Services, newServiceResponse(service))
+	}
+	return rsp
+}
+
+// swagger:model createAppointmentRequest
+type createAppointmentRequest struct {
+	// The ID of the Customer who books
+	// example: 1
+	CustomerID int64 `json:"customerId" binding:"required,min=1"`
+	// The ID of the Car to service, a Car of the Customer
+	// example: 1
+	CarID int32 `json:"carId" binding:"required,min=1"`
+	// The IDs of the Services requested, they set how long the bay is booked
+	// example: [1,2]
+	ServiceIDs []int32 `json:"serviceIds" binding:"required,min=1,unique,dive,min=1"`
+	// When the Car is expected
+	// example: 2022-06-01T09:00:00Z
+	StartsAt time.Time `json:"startsAt" binding:"required"`
+	// The bay to book, the first free bay when empty
+	// example: 1
+	Bay int32 `json:"bay" binding:"omitempty,min=1"`
+	// What the customer said on the phone
+	// example: brakes are noisy
+	Notes string `json:"notes" binding:"max=255"`
+}
+
+// createAppointment godoc
+// @Summary book an Appointment
+// @Description book a bay for a Car of a Customer, for the time the Services requested take.
+// @Description The Car cannot be booked twice at the same time and a bay holds one Car at a time.
+// @Tags Appointment
+// @ID create-Appointment
+// @Accept  json
+// @Produce  json
+// @Param Body body createAppointmentRequest true "The body to book an Appointment"
+// @Success 200 {object} AppointmentResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /appointments [post]
+func (server *Server) createAppointment(ctx *gin.Context) {
+	var req createAppointmentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	if req.Bay > server.config.BayCount {
+		err := fmt.Errorf("bay %d does not exist, the workshop has %d bays", req.Bay, server.config.BayCount)
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	arg := db.BookAppointmentTxParams{
+		CustomerID: req.CustomerID,
+		CarID:      req.CarID,
+		ServiceIDs: req.ServiceIDs,
+		StartsAt:   req.StartsAt,
+		Bay:        req.Bay,
+		Bays:       server.config.BayCount,
+		Notes:      req.Notes,
+	}
+	result, err := server.store.BookAppointmentTx(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newAppointmentResponse(result.Appointment, result.Services))
+}
+
+type getAppointmentRequest struct {
+	ID int32 `uri:"id" binding:"required,min=1"`
+}
+
+// getAppointment godoc
+// @Summary  GET Appointment
+// @Description  GET  Appointment by it's id, with the Services requested
+// @Tags Appointment
+// @ID get-Appointment
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id to get an Appointment"
+// @Success 200 {object} AppointmentResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /appointments/{id} [get]
+func (server *Server) getAppointment(ctx *gin.Context) {
+	var req getAppointmentRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	appointment, err := server.store.GetAppointment(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	services, err := server.store.ListAppointmentServices(ctx, appointment.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newAppointmentResponse(appointment, services))
+}
+
+// cancelAppointment godoc
+// @Summary cancel an Appointment
+// @Description cancel a booked Appointment, its bay is free again
+// @Tags Appointment
+// @ID cancel-Appointment
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id of the Appointment"
+// @Success 200 {object} AppointmentResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /appointments/{id}/cancel [post]
+func (server *Server) cancelAppointment(ctx *gin.Context) {
+	var req getAppointmentRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	appointment, err := server.store.GetAppointment(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	if !db.CanTransitionAppointment(appointment.State, db.AppointmentCancelled) {
+		err := fmt.Errorf("appointment %d is %s", appointment.ID, db.AppointmentStateName(appointment.State))
+		ctx.JSON(http.StatusConflict, errorResponse(codeConflict, err))
+		return
+	}
+
+	arg := db.UpdateAppointmentStateParams{
+		ID:             appointment.ID,
+		FromState:      appointment.State,
+		State:          db.AppointmentCancelled,
+		ServiceOrderID: appointment.ServiceOrderID,
+	}
+	appointment, err = server.store.UpdateAppointmentState(ctx, arg)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			// the appointment changed state since we read it
+			err := fmt.Errorf("appointment %d was modified concurrently, retry", arg.ID)
+			ctx.JSON(http.StatusConflict, errorResponse(codeConflict, err))
+			return
+		}
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	services, err := server.store.ListAppointmentServices(ctx, appointment.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newAppointmentResponse(appointment, services))
+}
+
+// swagger:model ArriveAppointmentResponse
+type ArriveAppointmentResponse struct {
+	// The Appointment, arrived
+	Appointment AppointmentResponse `json:"appointment"`
+	// The Service Order opened for the Car
+	ServiceOrder ServiceOrderResponse `json:"service_order"`
+	// The lines of the Service Order, one per Service requested at the min price of the catalog
+	ServiceDetails []ServiceDetailResponse `json:"service_details"`
+}
+
+// swagger:model arriveAppointmentRequest
+type arriveAppointmentRequest struct {
+	// The odometer reading of the Car, in kilometers
+	// example: 84500
+	Mileage *int32 `json:"mileage" binding:"omitempty,min=0"`
+}
+
+// arriveAppointment godoc
+// @Summary receive the Car of an Appointment
+// @Description open a Service Order for the Car of a booked Appointment, with a line for every Service requested
+// @Tags Appointment
+// @ID arrive-Appointment
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id of the Appointment"
+// @Param Body body arriveAppointmentRequest false "The state of the Car on arrival"
+// @Success 200 {object} ArriveAppointmentResponse
+// @Header 200 {string} ETag "The version of the Service Order, to send in If-Match"
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /appointments/{id}/arrive [post]
+func (server *Server) arriveAppointment(ctx *gin.Context) {
+	var req getAppointmentRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	// the body is optional, the mileage is not always read
+	var body arriveAppointmentRequest
+	if err := ctx.ShouldBindJSON(&body); err != nil && !errors.Is(err, io.EOF) {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	arg := db.ArriveAppointmentTxParams{ID: req.ID}
+	if body.Mileage != nil {
+		arg.Mileage = sql.NullInt32{Int32: *body.Mileage, Valid: true}
+	}
+	result, err := server.store.ArriveAppointmentTx(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	services, err := server.store.ListAppointmentServices(ctx, result.Appointment.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	rsp := ArriveAppointmentResponse{
+		Appointment:    newAppointmentResponse(result.Appointment, services),
+		ServiceOrder:   newServiceOrderResponse(result.ServiceOrder),
+		ServiceDetails: make([]ServiceDetailResponse, 0, len(result.ServiceDetails)),
+	}
+	for _, detail := range result.ServiceDetails {
+		rsp.ServiceDetails = append(rsp.ServiceDetails, newServiceDetailResponse(detail))
+	}
+
+	setETag(ctx, result.ServiceOrder.Version)
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+type getScheduleRequest struct {
+	Date time.Time `form:"date" binding:"required" time_format:"2006-01-02"`
+}
+
+// swagger:model BayScheduleResponse
+type BayScheduleResponse struct {
+	// The bay
+	// example: 1
+	Bay int32 `json:"bay"`
+	// The Appointments of the bay during the day, in the order of their start
+	Appointments []AppointmentResponse `json:"appointments"`
+}
+
+// swagger:model ScheduleResponse
+type ScheduleResponse struct {
+	// The day planned
+	// example: 2022-06-01
+	Date string `json:"date"`
+	// Every bay of the workshop, with its Appointments
+	Bays []BayScheduleResponse `json:"bays"`
+}
+
+// getSchedule godoc
+// @Summary GET the plan of a day
+// @Description GET, for every bay of the workshop, the booked and arrived Appointments taking it during a day
+// @Tags Appointment
+// @ID get-Schedule
+// @Accept  json
+// @Produce  json
+// @Param date query string true "The day in the timezone of the shop, as 2006-01-02"
+// @Success 200 {object} ScheduleResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /schedule [get]
+func (server *Server) getSchedule(ctx *gin.Context) {
+	var req getScheduleRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	// the day runs from midnight to midnight in the shop, a day across a DST change is not 24 hours
+	day := time.Date(req.Date.Year(), req.Date.Month(), req.Date.Day(), 0, 0, 0, 0, server.location)
+	arg := db.ListAppointmentsBetweenParams{
+		CancelledState: db.AppointmentCancelled,
+		FromTime:       day.UTC(),
+		ToTime:         day.AddDate(0, 0, 1).UTC(),
+	}
+	appointments, err := server.store.ListAppointmentsBetween(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	rows, err := server.store.ListAppointmentServicesBetween(ctx, db.ListAppointmentServicesBetweenParams(arg))
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	services := make(map[int32][]db.Service)
+	for _, row := range rows {
+		services[row.AppointmentID] = append(services[row.AppointmentID], db.Service{
+			ID:             row.ID,
+			Name:           row.Name,
+			Description:    row.Description,
+			EstimationTime: row.EstimationTime,
+			MinPrice:       row.MinPrice,
+			MaxPrice:       row.MaxPrice,
+		})
+	}
+
+	// the appointments booked on a bay since removed from the config are still planned
+	bays := server.config.BayCount
+	for _, appointment := range appointments {
+		if appointment.Bay > bays {
+			bays = appointment.Bay
+		}
+	}
+
+	rsp := ScheduleResponse{
+		Date: req.Date.Format("2006-01-02"),
+		Bays: make([]BayScheduleResponse, bays),
+	}
+	for i := range rsp.Bays {
+		rsp.Bays[i] = BayScheduleResponse{Bay: int32(i + 1), Appointments: []AppointmentResponse{}}
+	}
+	for _, appointment := range appointments {
+		bay := &rsp.Bays[appointment.Bay-1]
+		bay.Appointments = append(bay.Appointments, newAppointmentResponse(appointment, services[appointment.ID]))
+	}
+	ctx.JSON(http.StatusOK, rsp)
+}
@@ -0,0 +1,421 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockdb "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/mock"
+	db "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/sqlc"
+	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/util"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func randomAppointment(bay int32, startsAt time.Time) db.Appointment {
+	return db.Appointment{
+		ID:         int32(util.RandomInt(1, 1000)),
+		CustomerID: util.RandomInt(1, 1000),
+		CarID:      int32(util.RandomInt(1, 1000)),
+		Bay:        bay,
+		StartsAt:   startsAt,
+		EndsAt:     startsAt.Add(90 * time.Minute),
+		Notes:      util.RandomString(20),
+		State:      db.AppointmentBooked,
+	}
+}
+
+func TestCreateAppointmentAPI(t *testing.T) {
+	startsAt := time.Date(2030, 6, 1, 9, 0, 0, 0, time.UTC)
+	appointment := randomAppointment(1, startsAt)
+	service := db.Service{
+		ID:             int32(util.RandomInt(1, 1000)),
+		Name:           util.RandomString(12),
+		EstimationTime: 90,
+		MinPrice:       sql.NullString{String: "2500.00", Valid: true},
+		MaxPrice:       sql.NullString{String: "4000.00", Valid: true},
+	}
+	body := gin.H{
+		"customerId": appointment.CustomerID,
+		"carId":      appointment.CarID,
+		"serviceIds": []int32{service.ID},
+		"startsAt":   startsAt.Format(time.RFC3339),
+		"notes":      appointment.Notes,
+	}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		role          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: body,
+			role: util.RoleFrontDesk,
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.BookAppointmentTxParams{
+					CustomerID: appointment.CustomerID,
+					CarID:      appointment.CarID,
+					ServiceIDs: []int32{service.ID},
+					StartsAt:   startsAt,
+					Bays:       2,
+					Notes:      appointment.Notes,
+				}
+				store.EXPECT().
+					BookAppointmentTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.BookAppointmentTxResult{Appointment: appointment, Services: []db.Service{service}}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got AppointmentResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &got))
+				require.Equal(t, newAppointmentResponse(appointment, []db.Service{service}), got)
+				require.Equal(t, "booked", got.State)
+			},
+		},
+		{
+			name: "BayBooked",
+			body: body,
+			role: util.RoleFrontDesk,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					BookAppointmentTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.BookAppointmentTxResult{}, &db.ConflictError{})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+				require.Contains(t, recorder.Body.String(), codeConflict)
+			},
+		},
+		{
+			name: "CarNotFound",
+			body: body,
+			role: util.RoleFrontDesk,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					BookAppointmentTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.BookAppointmentTxResult{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeNotFound)
+			},
+		},
+		{
+			name: "NoSuchBay",
+			body: gin.H{
+				"customerId": appointment.CustomerID,
+				"carId":      appointment.CarID,
+				"serviceIds": []int32{service.ID},
+				"startsAt":   startsAt.Format(time.RFC3339),
+				"bay":        3,
+			},
+			role: util.RoleFrontDesk,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					BookAppointmentTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInvalidRequest)
+			},
+		},
+		{
+			name: "DuplicateServices",
+			body: gin.H{
+				"customerId": appointment.CustomerID,
+				"carId":      appointment.CarID,
+				"serviceIds": []int32{service.ID, service.ID},
+				"startsAt":   startsAt.Format(time.RFC3339),
+			},
+			role: util.RoleFrontDesk,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					BookAppointmentTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInvalidRequest)
+			},
+		},
+		{
+			name: "MechanicForbidden",
+			body: body,
+			role: util.RoleMechanic,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					BookAppointmentTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeForbidden)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/appointments", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, util.RandomName(), tc.role, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestGetScheduleAPI(t *testing.T) {
+	// the test shop is in Algiers, UTC+1 all year
+	day := time.Date(2030, 5, 31, 23, 0, 0, 0, time.UTC)
+	morning := randomAppointment(2, day.Add(9*time.Hour))
+	afternoon := randomAppointment(2, day.Add(14*time.Hour))
+	service := db.ListAppointmentServicesBetweenRow{
+		AppointmentID:  morning.ID,
+		ID:             int32(util.RandomInt(1, 1000)),
+		Name:           util.RandomString(12),
+		EstimationTime: 90,
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK",
+			query: "date=2030-06-01",
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListAppointmentsBetweenParams{
+					CancelledState: db.AppointmentCancelled,
+					FromTime:       day,
+					ToTime:         day.AddDate(0, 0, 1),
+				}
+				store.EXPECT().
+					ListAppointmentsBetween(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return([]db.Appointment{morning, afternoon}, nil)
+				store.EXPECT().
+					ListAppointmentServicesBetween(gomock.Any(), gomock.Eq(db.ListAppointmentServicesBetweenParams(arg))).
+					Times(1).
+					Return([]db.ListAppointmentServicesBetweenRow{service}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got ScheduleResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &got))
+				require.Equal(t, "2030-06-01", got.Date)
+
+				// every bay is planned, even a free one
+				require.Len(t, got.Bays, 2)
+				require.Equal(t, int32(1), got.Bays[0].Bay)
+				require.Empty(t, got.Bays[0].Appointments)
+
+				require.Equal(t, int32(2), got.Bays[1].Bay)
+				require.Len(t, got.Bays[1].Appointments, 2)
+				require.Equal(t, morning.ID, got.Bays[1].Appointments[0].ID)
+				require.Len(t, got.Bays[1].Appointments[0].Services, 1)
+				require.Equal(t, service.Name, got.Bays[1].Appointments[0].Services[0].Name)
+				require.Equal(t, afternoon.ID, got.Bays[1].Appointments[1].ID)
+				require.Empty(t, got.Bays[1].Appointments[1].Services)
+			},
+		},
+		{
+			name:  "InvalidDate",
+			query: "date=tomorrow",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAppointmentsBetween(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInvalidRequest)
+			},
+		},
+		{
+			name:  "InternalError",
+			query: "date=2030-06-01",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAppointmentsBetween(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+				store.EXPECT().
+					ListAppointmentServicesBetween(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInternal)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/schedule?"+tc.query, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, util.RandomName(), util.RoleMechanic, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestArriveAppointmentAPI(t *testing.T) {
+	appointment := randomAppointment(1, time.Date(2030, 6, 1, 9, 0, 0, 0, time.UTC))
+	appointment.State = db.AppointmentArrived
+	order := db.ServiceOrder{
+		ID:      int32(util.RandomInt(1, 1000)),
+		CarID:   appointment.CarID,
+		State:   db.ServiceOrderOpen,
+		Version: 1,
+		Mileage: sql.NullInt32{Int32: 84500, Valid: true},
+	}
+	appointment.ServiceOrderID = sql.NullInt32{Int32: order.ID, Valid: true}
+	result := db.ArriveAppointmentTxResult{Appointment: appointment, ServiceOrder: order}
+
+	testCases := []struct {
+		name          string
+		body          []byte
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: []byte(`{"mileage": 84500}`),
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ArriveAppointmentTxParams{ID: appointment.ID, Mileage: order.Mileage}
+				store.EXPECT().
+					ArriveAppointmentTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(result, nil)
+				store.EXPECT().
+					ListAppointmentServices(gomock.Any(), gomock.Eq(appointment.ID)).
+					Times(1).
+					Return([]db.Service{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, `"1"`, recorder.Header().Get("ETag"))
+
+				var got ArriveAppointmentResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &got))
+				require.Equal(t, "arrived", got.Appointment.State)
+				require.Equal(t, order.ID, got.Appointment.ServiceOrderID)
+				require.Equal(t, order.ID, got.ServiceOrder.ID)
+				require.Equal(t, int32(84500), *got.ServiceOrder.Mileage)
+			},
+		},
+		{
+			name: "NoBody",
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ArriveAppointmentTxParams{ID: appointment.ID}
+				store.EXPECT().
+					ArriveAppointmentTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(result, nil)
+				store.EXPECT().
+					ListAppointmentServices(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.Service{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "NegativeMileage",
+			body: []byte(`{"mileage": -1}`),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ArriveAppointmentTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInvalidRequest)
+			},
+		},
+		{
+			name: "NotBooked",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ArriveAppointmentTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ArriveAppointmentTxResult{}, &db.ConflictError{})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+				require.Contains(t, recorder.Body.String(), codeConflict)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/appointments/%d/arrive", appointment.ID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(tc.body))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, util.RandomName(), util.RoleFrontDesk, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,426 @@
+package api
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"time"
+
+	db "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/sqlc"
+	"github.com/gin-gonic/gin"
+)
+
+// swagger:model CarResponse
+type CarResponse struct {
+	// The ID of a Car
+	// example: 1
+	ID int32 `json:"id"`
+	// The ID of the Customer owning the Car
+	// example: 1
+	CustomerID int32 `json:"customer_id"`
+	// The registration number of a Car
+	// example: 12345-116-16
+	RegistraionNumber string `json:"registraion_number"`
+	// The Make of a Car
+	// example: Renault
+	Make string `json:"make"`
+	// The Model of a Car
+	// example: Clio
+	Model string `json:"model"`
+	// The Year of a Car
+	// example: 2016
+	Year string `json:"year"`
+	// The Energy of a Car
+	// example: diesel
+	Energy string `json:"energy"`
+	// The version of a Car, bumped by every update
+	// example: 1
+	Version int32 `json:"version"`
+	// The time a Car was deleted, empty unless it is deleted
+	// example: 2022-06-03T10:15:00Z
+	DeletedAt *time.Time `json:"deleted_at"`
+}
+
+func newCarResponse(car db.Car) CarResponse {
+	return CarResponse{
+		ID:                car.ID,
+		CustomerID:        car.CustomerID,
+		RegistraionNumber: car.RegistraionNumber,
+		Make:              car.Make,
+		Model:             car.Model,
+		Year:              car.Year,
+		Energy:            car.Energy,
+		Version:           car.Version,
+		DeletedAt:         nullTime(car.DeletedAt),
+	}
+}
+
+func newCarsResponse(cars []db.Car) []CarResponse {
+	rsp := make([]CarResponse, 0, len(cars))
+	for _, car := range cars {
+		rsp = append(rsp, newCarResponse(car))
+	}
+	return rsp
+}
+
+// swagger:model createCarRequest
+type createCarRequest struct {
+	// The ID of the Customer owning the Car
+	// example: 1
+	CustomerID int32 `json:"customerId" binding:"required,min=1"`
+	// The registration number of a Car
+	// example: 12345-116-16
+	RegistrationNumber string `json:"registrationNumber" binding:"required"`
+	// The Make of a Car
+	// example: Renault
+	Make string `json:"make" binding:"required"`
+	// The Model of a Car
+	// example: Clio
+	Model string `json:"model" binding:"required"`
+	// The Year of a Car
+	// example: 2016
+	Year string `json:"year" binding:"required"`
+	// The Energy of a Car
+	// example: diesel
+	Energy string `json:"energy" binding:"required"`
+}
+
+// createCar godoc
+// @Summary Create new Car
+// @Description Register a new Car against a Customer
+// @ID create-Car
+// @Tags Car
+// @Accept  json
+// @Produce  json
+// @Param Body body createCarRequest true "The body to create a Car"
+// @Success 200 {object} CarResponse
+// @Header 200 {string} ETag "The version of the Car, to send in If-Match"
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /cars [post]
+func (server *Server) createCar(ctx *gin.Context) {
+	var req createCarRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	arg := db.CreateCarParams{
+		CustomerID:        req.CustomerID,
+		RegistraionNumber: req.RegistrationNumber,
+		Make:              req.Make,
+		Model:             req.Model,
+		Year:              req.Year,
+		Energy:            req.Energy,
+	}
+	car, err := server.store.CreateCar(ctx, arg)
+	if err == sql.ErrNoRows {
+		// the car is only inserted for a customer that is not deleted
+		err := fmt.Errorf("customer %d does not exist", req.CustomerID)
+		ctx.JSON(http.StatusUnprocessableEntity, errorResponse(codeForeignKeyViolation, err))
+		return
+	}
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	setETag(ctx, car.Version)
+	ctx.JSON(http.StatusOK, newCarResponse(car))
+}
+
+// swagger:model getCarRequest
+type getCarRequest struct {
+	// The id of a Car
+	// in:path
+	ID int32 `uri:"id" binding:"required,min=1"`
+}
+
+// getCar godoc
+// @Summary  GET Car
+// @Description  GET  Car by it's id
+// @Tags Car
+// @ID get-Car
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id to get a Car"
+// @Success 200 {object} CarResponse
+// @Header 200 {string} ETag "The version of the Car, to send in If-Match"
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /cars/{id} [get]
+func (server *Server) getCar(ctx *gin.Context) {
+	var req getCarRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	car, err := server.store.GetCar(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	setETag(ctx, car.Version)
+	ctx.JSON(http.StatusOK, newCarResponse(car))
+}
+
+// swagger:model ListCarsRequest
+type ListCarsRequest struct {
+	pageRequest
+}
+
+// listCars godoc
+// @Summary list all Cars
+// @Description GET list of all Cars
+// @Tags Car
+// @ID list-Car
+// @Accept  json
+// @Produce  json
+// @Param cursor query string false "The next_cursor of the previous page, none for the first page"
+// @Param page_size query int false "The number of Cars per page, 20 by default"
+// @Param sort query string false "The field to sort by, descending when prefixed with a -: customer_id, make, model, year or id (default)"
+// @Param customer_id query int false "Only the Cars of this Customer"
+// @Param make_prefix query string false "Only the Cars whose make starts with it, ignoring case"
+// @Param model_prefix query string false "Only the Cars whose model starts with it, ignoring case"
+// @Param registration_prefix query string false "Only the Cars whose registration number starts with it, ignoring case"
+// @Param energy query string false "Only the Cars of this energy"
+// @Param year query string false "Only the Cars of this year"
+// @Success 200 {object} PageResponse{items=[]CarResponse}
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /cars [get]
+func (server *Server) listCars(ctx *gin.Context) {
+	var req ListCarsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+	query, err := carListSpec.parse(ctx.Request.URL.Query(), req.pageRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	cars, err := server.store.ListCarsWhere(ctx, query.params)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+	totalCount, err := server.store.CountCarsWhere(ctx, query.params.Where)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+	cars, nextCursor := carListSpec.nextPage(cars, query)
+
+	ctx.JSON(http.StatusOK, PageResponse{Items: newCarsResponse(cars), NextCursor: nextCursor, TotalCount: totalCount})
+}
+
+// listCustomerCars godoc
+// @Summary list the Cars of a Customer
+// @Description GET list of all Cars registered against a Customer
+// @Tags Car
+// @ID list-Customer-Car
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id of the Customer"
+// @Success 200 {array} CarResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /customers/{id}/cars [get]
+func (server *Server) listCustomerCars(ctx *gin.Context) {
+	var req getCustomerRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	customer, err := server.store.GetCustomer(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	cars, err := server.store.ListCarsByCustomer(ctx, int32(customer.ID))
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newCarsResponse(cars))
+}
+
+type deleteCarRequest struct {
+	ID int32 `uri:"id" binding:"required,min=1"`
+}
+
+// deleteCar godoc
+// @Summary DELETE a Car
+// @Description use this api to delete a car by it's id, the car is hidden until it is restored
+// @Tags Car
+// @ID delete-Car
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id to delete a Car"
+// @Param If-Match header string false "The ETag of the version to delete, the request fails with a 412 when the Car changed since"
+// @Success 204 string deleted
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /cars/{id} [delete]
+func (server *Server) deleteCar(ctx *gin.Context) {
+	var req deleteCarRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	cond, ok := bindIfMatch(ctx)
+	if !ok {
+		return
+	}
+
+	rows, err := server.store.SoftDeleteCar(ctx, db.SoftDeleteCarParams{ID: req.ID, Versions: cond.versions})
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+	if rows == 0 {
+		ctx.JSON(cond.errorResponse(sql.ErrNoRows))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, "deleted")
+}
+
+// restoreCar godoc
+// @Summary restore a deleted Car
+// @Description restore a deleted Car, the Car of a deleted Customer is restored with the Customer. A Car that is not deleted is a 409.
+// @Tags Car
+// @ID restore-Car
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id of the deleted Car"
+// @Success 200 {object} CarResponse
+// @Header 200 {string} ETag "The version of the Car, to send in If-Match"
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /cars/{id}/restore [post]
+func (server *Server) restoreCar(ctx *gin.Context) {
+	var req getCarRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	car, err := server.store.RestoreCarTx(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	setETag(ctx, car.Version)
+	ctx.JSON(http.StatusOK, newCarResponse(car))
+}
+
+// swagger:model updateCarRequest
+type updateCarRequest struct {
+	// The ID of the Customer owning the Car
+	// example: 1
+	CustomerID int32 `json:"customerId" binding:"required,min=1"`
+	// The registration number of a Car
+	// example: 12345-116-16
+	RegistrationNumber string `json:"registrationNumber" binding:"required"`
+	// The Make of a Car
+	// example: Renault
+	Make string `json:"make" binding:"required"`
+	// The Model of a Car
+	// example: Clio
+	Model string `json:"model" binding:"required"`
+	// The Year of a Car
+	// example: 2016
+	Year string `json:"year" binding:"required"`
+	// The Energy of a Car
+	// example: diesel
+	Energy string `json:"energy" binding:"required"`
+}
+
+// updateCar godoc
+// @Summary update  Car
+// @Description update a  Car
+// @Tags Car
+// @ID update-Car
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id to update a Car"
+// @Param Body body updateCarRequest true "The body to update a Car"
+// @Param If-Match header string false "The ETag of the version to change, the request fails with a 412 when the Car changed since"
+// @Success 200 {object} CarResponse
+// @Header 200 {string} ETag "The version of the Car, to send in If-Match"
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
+// @Failure 422 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /cars/{id} [put]
+func (server *Server) updateCar(ctx *gin.Context) {
+	var uri getCarRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	var req updateCarRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	cond, ok := bindIfMatch(ctx)
+	if !ok {
+		return
+	}
+
+	arg := db.UpdateCarParams{
+		ID:                uri.ID,
+		CustomerID:        req.CustomerID,
+		RegistraionNumber: req.RegistrationNumber,
+		Make:              req.Make,
+		Model:             req.Model,
+		Year:              req.Year,
+		Energy:            req.Energy,
+		Versions:          cond.versions,
+	}
+
+	car, err := server.store.UpdateCar(ctx, arg)
+	if err == sql.ErrNoRows {
+		// the car is only moved to a customer that is not deleted, tell it apart from a
+		// missing car or an outdated version
+		if _, getErr := server.store.GetCustomer(ctx, int64(req.CustomerID)); getErr == sql.ErrNoRows {
+			err := fmt.Errorf("customer %d does not exist", req.CustomerID)
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(codeForeignKeyViolation, err))
+			return
+		}
+	}
+	if err != nil {
+		ctx.JSON(cond.errorResponse(err))
+		return
+	}
+
+	setETag(ctx, car.Version)
+	ctx.JSON(http.StatusOK, newCarResponse(car))
+}
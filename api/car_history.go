@@ -0,0 +1,176 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	db "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/sqlc"
+	"github.com/gin-gonic/gin"
+)
+
+// swagger:model ServiceHistoryResponse
+type ServiceHistoryResponse struct {
+	// The ID of the Service done
+	// example: 1
+	ServiceID int32 `json:"service_id"`
+	// The Name of the Service
+	// example: oil change
+	Name string `json:"name"`
+	// The price charged
+	// example: 2500.00
+	Price string `json:"price"`
+	// The state of the work on the line
+	// example: done
+	State string `json:"state"`
+}
+
+// swagger:model PartHistoryResponse
+type PartHistoryResponse struct {
+	// The ID of the Part used
+	// example: 1
+	PartID int32 `json:"part_id"`
+	// The Name of the Part
+	// example: oil filter
+	Name string `json:"name"`
+	// The quantity used
+	// example: 1
+	Quantity int32 `json:"quantity"`
+	// The price charged for one
+	// example: 1200.00
+	Price string `json:"price"`
+}
+
+// swagger:model InvoiceRefResponse
+type InvoiceRefResponse struct {
+	// The ID of the Sale Invoice
+	// example: 1
+	ID int32 `json:"id"`
+	// The sequential reference of the invoice
+	// example: INV-000042
+	Ref string `json:"ref"`
+	// The day the invoice was issued
+	// example: 2022-06-03T00:00:00Z
+	Date *time.Time `json:"date"`
+}
+
+// swagger:model ServiceOrderHistoryResponse
+type ServiceOrderHistoryResponse struct {
+	// The Service Order
+	ServiceOrder ServiceOrderResponse `json:"service_order"`
+	// The Services done
+	Services []ServiceHistoryResponse `json:"services"`
+	// The Parts used
+	Parts []PartHistoryResponse `json:"parts"`
+	// The Mechanics who worked on the Car
+	Mechanics []db.Mechanic `json:"mechanics"`
+	// The invoice of the Service Order, empty until it is invoiced
+	Invoice *InvoiceRefResponse `json:"invoice"`
+}
+
+// getCarHistory godoc
+// @Summary GET the service history of a Car
+// @Description GET the Service Orders of a Car, oldest first, with the Services done, the Parts used, the Mechanics,
+// @Description the mileage when the Car was received and the invoice refs. The history of a deleted Car is still returned.
+// @Tags Car
+// @ID get-Car-history
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id of the Car"
+// @Success 200 {array} ServiceOrderHistoryResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /cars/{id}/history [get]
+func (server *Server) getCarHistory(ctx *gin.Context) {
+	var req getCarRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	// the history of a deleted car is kept with its service orders and invoices
+	car, err := server.store.GetCarWithDeleted(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	orders, err := server.store.ListCarServiceOrders(ctx, car.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	rsp := make([]ServiceOrderHistoryResponse, len(orders))
+	entries := make(map[int32]*ServiceOrderHistoryResponse, len(orders))
+	for i, order := range orders {
+		rsp[i] = ServiceOrderHistoryResponse{
+			ServiceOrder: newServiceOrderResponse(order),
+			Services:     []ServiceHistoryResponse{},
+			Parts:        []PartHistoryResponse{},
+			Mechanics:    []db.Mechanic{},
+		}
+		entries[order.ID] = &rsp[i]
+	}
+
+	services, err := server.store.ListCarServiceDetails(ctx, car.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+	for _, service := range services {
+		if entry, ok := entries[service.ServiceOrderID]; ok {
+			entry.Services = append(entry.Services, ServiceHistoryResponse{
+				ServiceID: service.ServiceID,
+				Name:      service.Name,
+				Price:     service.Price.String,
+				State:     db.ServiceDetailStateName(service.State),
+			})
+		}
+	}
+
+	parts, err := server.store.ListCarPartDetails(ctx, car.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+	for _, part := range parts {
+		if entry, ok := entries[part.ServiceOrderID]; ok {
+			entry.Parts = append(entry.Parts, PartHistoryResponse{
+				PartID:   part.PartID,
+				Name:     part.Name,
+				Quantity: part.Quantity,
+				Price:    part.Price.String,
+			})
+		}
+	}
+
+	mechanics, err := server.store.ListCarMechanics(ctx, car.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+	for _, mechanic := range mechanics {
+		if entry, ok := entries[mechanic.ServiceOrderID]; ok {
+			entry.Mechanics = append(entry.Mechanics, db.Mechanic{ID: mechanic.ID, FullName: mechanic.FullName})
+		}
+	}
+
+	invoices, err := server.store.ListCarSaleInvoices(ctx, car.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+	for _, invoice := range invoices {
+		if entry, ok := entries[invoice.ServiceOrderID]; ok {
+			entry.Invoice = &InvoiceRefResponse{
+				ID:   invoice.ID,
+				Ref:  invoice.Ref,
+				Date: nullTime(invoice.Date),
+			}
+		}
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
+}
@@ -0,0 +1,178 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockdb "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/mock"
+	db "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/sqlc"
+	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCarHistoryAPI(t *testing.T) {
+	car := db.Car{
+		ID:                int32(util.RandomInt(1, 1000)),
+		CustomerID:        int32(util.RandomInt(1, 1000)),
+		RegistraionNumber: util.RandomString(10),
+	}
+	first := db.ServiceOrder{
+		ID:           int32(util.RandomInt(1, 1000)),
+		CarID:        car.ID,
+		DateReceived: sql.NullTime{Time: time.Date(2022, 1, 10, 0, 0, 0, 0, time.UTC), Valid: true},
+		State:        db.ServiceOrderReturned,
+		Version:      4,
+		Mileage:      sql.NullInt32{Int32: 61200, Valid: true},
+	}
+	second := db.ServiceOrder{
+		ID:           first.ID + 1,
+		CarID:        car.ID,
+		DateReceived: sql.NullTime{Time: time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+		State:        db.ServiceOrderOpen,
+		Version:      1,
+	}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetCarWithDeleted(gomock.Any(), gomock.Eq(car.ID)).Times(1).Return(car, nil)
+				store.EXPECT().
+					ListCarServiceOrders(gomock.Any(), gomock.Eq(car.ID)).
+					Times(1).
+					Return([]db.ServiceOrder{first, second}, nil)
+				store.EXPECT().
+					ListCarServiceDetails(gomock.Any(), gomock.Eq(car.ID)).
+					Times(1).
+					Return([]db.ListCarServiceDetailsRow{{
+						ServiceOrderID: first.ID,
+						ServiceID:      3,
+						Name:           "oil change",
+						Price:          sql.NullString{String: "2500.00", Valid: true},
+						State:          db.ServiceDetailDone,
+					}}, nil)
+				store.EXPECT().
+					ListCarPartDetails(gomock.Any(), gomock.Eq(car.ID)).
+					Times(1).
+					Return([]db.ListCarPartDetailsRow{{
+						ServiceOrderID: first.ID,
+						PartID:         7,
+						Name:           "oil filter",
+						Quantity:       1,
+						Price:          sql.NullString{String: "1200.00", Valid: true},
+					}}, nil)
+				store.EXPECT().
+					ListCarMechanics(gomock.Any(), gomock.Eq(car.ID)).
+					Times(1).
+					Return([]db.ListCarMechanicsRow{{ServiceOrderID: second.ID, ID: 2, FullName: "Amine"}}, nil)
+				store.EXPECT().
+					ListCarSaleInvoices(gomock.Any(), gomock.Eq(car.ID)).
+					Times(1).
+					Return([]db.SaleInvoice{{ID: 9, ServiceOrderID: first.ID, Ref: "INV-000009", Total: "3700.00"}}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got []ServiceOrderHistoryResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &got))
+				require.Len(t, got, 2)
+
+				require.Equal(t, first.ID, got[0].ServiceOrder.ID)
+				require.Equal(t, int32(61200), *got[0].ServiceOrder.Mileage)
+				require.Equal(t, []ServiceHistoryResponse{{ServiceID: 3, Name: "oil change", Price: "2500.00", State: "done"}}, got[0].Services)
+				require.Equal(t, []PartHistoryResponse{{PartID: 7, Name: "oil filter", Quantity: 1, Price: "1200.00"}}, got[0].Parts)
+				require.Empty(t, got[0].Mechanics)
+				require.Equal(t, "INV-000009", got[0].Invoice.Ref)
+
+				require.Equal(t, second.ID, got[1].ServiceOrder.ID)
+				require.Nil(t, got[1].ServiceOrder.Mileage)
+				require.Empty(t, got[1].Services)
+				require.Equal(t, []db.Mechanic{{ID: 2, FullName: "Amine"}}, got[1].Mechanics)
+				require.Nil(t, got[1].Invoice)
+			},
+		},
+		{
+			name: "DeletedCar",
+			buildStubs: func(store *mockdb.MockStore) {
+				deleted := car
+				deleted.DeletedAt = sql.NullTime{Time: time.Now(), Valid: true}
+				store.EXPECT().GetCarWithDeleted(gomock.Any(), gomock.Eq(car.ID)).Times(1).Return(deleted, nil)
+				store.EXPECT().
+					ListCarServiceOrders(gomock.Any(), gomock.Eq(car.ID)).
+					Times(1).
+					Return([]db.ServiceOrder{first}, nil)
+				store.EXPECT().ListCarServiceDetails(gomock.Any(), gomock.Eq(car.ID)).Times(1).Return(nil, nil)
+				store.EXPECT().ListCarPartDetails(gomock.Any(), gomock.Eq(car.ID)).Times(1).Return(nil, nil)
+				store.EXPECT().ListCarMechanics(gomock.Any(), gomock.Eq(car.ID)).Times(1).Return(nil, nil)
+				store.EXPECT().ListCarSaleInvoices(gomock.Any(), gomock.Eq(car.ID)).Times(1).Return(nil, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got []ServiceOrderHistoryResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &got))
+				require.Len(t, got, 1)
+				require.Equal(t, first.ID, got[0].ServiceOrder.ID)
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetCarWithDeleted(gomock.Any(), gomock.Eq(car.ID)).Times(1).Return(db.Car{}, sql.ErrNoRows)
+				store.EXPECT().ListCarServiceOrders(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeNotFound)
+			},
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetCarWithDeleted(gomock.Any(), gomock.Eq(car.ID)).Times(1).Return(car, nil)
+				store.EXPECT().
+					ListCarServiceOrders(gomock.Any(), gomock.Eq(car.ID)).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+				store.EXPECT().ListCarServiceDetails(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInternal)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/cars/%d/history", car.ID)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, util.RandomName(), util.RoleMechanic, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
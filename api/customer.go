@@ -3,10 +3,11 @@ package api
 import (
 	"database/sql"
 	"net/http"
-	"strconv"
+	"strings"
 	"time"
 
 	db "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/sqlc"
+	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/util"
 	"github.com/gin-gonic/gin"
 )
 
@@ -24,6 +25,31 @@ type CustomerResponse struct {
 	// The time a Customer was created
 	// example: 2021-05-25T00:53:16.535668Z
 	CreatedAt time.Time `json:"created_at"`
+	// The version of a Customer, bumped by every update
+	// example: 1
+	Version int32 `json:"version"`
+	// The time a Customer was deleted, empty unless it is deleted
+	// example: 2022-06-03T10:15:00Z
+	DeletedAt *time.Time `json:"deleted_at"`
+}
+
+func newCustomerResponse(customer db.Customer) CustomerResponse {
+	return CustomerResponse{
+		ID:          customer.ID,
+		FullName:    customer.FullName,
+		PhoneNumber: customer.PhoneNumber,
+		CreatedAt:   customer.CreatedAt,
+		Version:     customer.Version,
+		DeletedAt:   nullTime(customer.DeletedAt),
+	}
+}
+
+func newCustomersResponse(customers []db.Customer) []CustomerResponse {
+	rsp := make([]CustomerResponse, 0, len(customers))
+	for _, customer := range customers {
+		rsp = append(rsp, newCustomerResponse(customer))
+	}
+	return rsp
 }
 
 // swagger:model createCustomerRequest
@@ -31,13 +57,9 @@ type createCustomerRequest struct {
 	// The Name of a Customer
 	// example: Karim Stam
 	FullName string `json:"fullName" binding:"required"`
-	// The PhoneNumber for a Customer
-	// example: +2131122334455
-	PhoneNumber string `json:"phoneNumber" binding:"required"`
-}
-
-type ErrorResponse struct {
-	Error string `json:"error"`
+	// The PhoneNumber for a Customer, normalized to E.164
+	// example: +213550123456
+	PhoneNumber string `json:"phoneNumber" binding:"required,phone"`
 }
 
 // createCustomer godoc
@@ -49,27 +71,36 @@ type ErrorResponse struct {
 // @Produce  json
 // @Param Body body createCustomerRequest true "The body to create a Customer"
 // @Success 200 {object} CustomerResponse
+// @Header 200 {string} ETag "The version of the Customer, to send in If-Match"
 // @Failure 400 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
 // @Router /customers [post]
 func (server *Server) createCustomer(ctx *gin.Context) {
 	var req createCustomerRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	phoneNumber, err := util.NormalizePhone(req.PhoneNumber, server.config.DefaultPhoneRegion)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
 		return
 	}
 
 	arg := db.CreateCustomerParams{
 		FullName:    req.FullName,
-		PhoneNumber: req.PhoneNumber,
+		PhoneNumber: phoneNumber,
 	}
 	customer, err := server.store.CreateCustomer(ctx, arg)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		ctx.JSON(dbErrorResponse(err))
 		return
 	}
 
-	ctx.JSON(http.StatusOK, customer)
+	setETag(ctx, customer.Version)
+	ctx.JSON(http.StatusOK, newCustomerResponse(customer))
 }
 
 // swagger:model getCustomerRequest
@@ -88,37 +119,33 @@ type getCustomerRequest struct {
 // @Produce  json
 // @Param id path string true  "The id to get a Customer"
 // @Success 200 {object} CustomerResponse
+// @Header 200 {string} ETag "The version of the Customer, to send in If-Match"
 // @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
 // @Router /customers/{id} [get]
 func (server *Server) getCustomer(ctx *gin.Context) {
 	var req getCustomerRequest
 	if err := ctx.ShouldBindUri(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
 		return
 	}
 
 	customer, err := server.store.GetCustomer(ctx, req.ID)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			ctx.JSON(http.StatusNotFound, errorResponse(err))
-		}
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		ctx.JSON(dbErrorResponse(err))
 		return
 	}
 
-	ctx.JSON(http.StatusOK, customer)
+	setETag(ctx, customer.Version)
+	ctx.JSON(http.StatusOK, newCustomerResponse(customer))
 
 }
 
-type CustomersResponse struct {
-	Customers []CustomerResponse `json:"customers"`
-} // @name CustomersResponse
-
 // swagger:model ListCustomersRequest
 type ListCustomersRequest struct {
-	PageID   int32 `form:"page_id" binding:"required,min=1"`
-	PageSize int32 `form:"page_size" binding:"required,min=5,max=10"`
+	pageRequest
 }
 
 // listCustomers godoc
@@ -128,30 +155,108 @@ type ListCustomersRequest struct {
 // @ID list-Customer
 // @Accept  json
 // @Produce  json
-// @Param Body body ListCustomersRequest true "The body to list all Customers by pagination"
-// @Success 200 {object} CustomersResponse
+// @Param cursor query string false "The next_cursor of the previous page, none for the first page"
+// @Param page_size query int false "The number of Customers per page, 20 by default"
+// @Param sort query string false "The field to sort by, descending when prefixed with a -: full_name, created_at or id (default)"
+// @Param name_prefix query string false "Only the Customers whose name starts with it, ignoring case"
+// @Param created_after query string false "Only the Customers created on or after this date or RFC 3339 time"
+// @Param created_before query string false "Only the Customers created before this date or RFC 3339 time"
+// @Success 200 {object} PageResponse{items=[]CustomerResponse}
 // @Success 400 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
 // @Router /customers [get]
 func (server *Server) listCustomers(ctx *gin.Context) {
 	var req ListCustomersRequest
 	if err := ctx.ShouldBindQuery(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
 		return
 	}
-	arg := db.ListCustomersParams{
-		Limit:  req.PageSize,
-		Offset: (req.PageID - 1) * req.PageSize,
+	query, err := customerListSpec.parse(ctx.Request.URL.Query(), req.pageRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	customers, err := server.store.ListCustomersWhere(ctx, query.params)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
 	}
-	customers, err := server.store.ListCustomers(ctx, arg)
+	totalCount, err := server.store.CountCustomersWhere(ctx, query.params.Where)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		ctx.JSON(dbErrorResponse(err))
 		return
 	}
+	customers, nextCursor := customerListSpec.nextPage(customers, query)
 
-	ctx.JSON(http.StatusOK, customers)
+	ctx.JSON(http.StatusOK, PageResponse{Items: newCustomersResponse(customers), NextCursor: nextCursor, TotalCount: totalCount})
+
+}
 
+// swagger:model SearchCustomersRequest
+type SearchCustomersRequest struct {
+	// A part of the name or of the phone number of a Customer
+	// example: karim
+	Query string `form:"q" binding:"required,min=2"`
+	// The number of Customers to return, 10 by default
+	Limit int32 `form:"limit" binding:"omitempty,min=1,max=50"`
+}
+
+// likeEscaper escapes the wildcards of ILIKE so they match literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// searchCustomers godoc
+// @Summary search Customers
+// @Description Find the Customers whose name or phone number contains the query, ignoring case and the spaces, dashes or leading 0 of a phone number, the closest matches first
+// @Tags Customer
+// @ID search-Customer
+// @Accept  json
+// @Produce  json
+// @Param q query string true "A part of the name or of the phone number"
+// @Param limit query int false "The number of Customers to return, 10 by default"
+// @Success 200 {array} CustomerResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /customers/search [get]
+func (server *Server) searchCustomers(ctx *gin.Context) {
+	var req SearchCustomersRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+	if req.Limit == 0 {
+		req.Limit = 10
+	}
+
+	query := strings.TrimSpace(req.Query)
+	arg := db.SearchCustomersParams{
+		NamePattern: "%" + likeEscaper.Replace(query) + "%",
+		Digits:      phoneDigits(query),
+		Query:       query,
+		Limit:       req.Limit,
+	}
+	customers, err := server.store.SearchCustomers(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newCustomersResponse(customers))
+}
+
+// phoneDigits keeps the digits of a phone number as phone_digits does in the database,
+// without the leading 0 of a local number so "0550" also finds "+213 550"
+func phoneDigits(phone string) string {
+	var digits strings.Builder
+	for _, r := range phone {
+		if r >= '0' && r <= '9' {
+			digits.WriteRune(r)
+		}
+	}
+	return strings.TrimLeft(digits.String(), "0")
 }
 
 type deleteCustomerRequest struct {
@@ -160,30 +265,35 @@ type deleteCustomerRequest struct {
 
 // deleteCustomer godoc
 // @Summary DELETE a Customer
-// @Description use this api to delete a customer by it's id
+// @Description use this api to delete a customer by it's id, the customer and its cars are hidden until it is restored
 // @Tags Customer
 // @ID delete-Customer
 // @Accept  json
 // @Produce  json
 // @Param id path string true  "The id to delete a Customer"
+// @Param If-Match header string false "The ETag of the version to delete, the request fails with a 412 when the Customer changed since"
 // @Success 204 string deleted
 // @Failure 400 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
 // @Router /customers/{id} [delete]
 func (server *Server) deleteCustomer(ctx *gin.Context) {
 	var req deleteCustomerRequest
 	if err := ctx.ShouldBindUri(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	cond, ok := bindIfMatch(ctx)
+	if !ok {
 		return
 	}
 
-	err := server.store.DeleteCustomer(ctx, req.ID)
+	err := server.store.DeleteCustomerTx(ctx, db.SoftDeleteCustomerParams{ID: req.ID, Versions: cond.versions})
 	if err != nil {
-		if err == sql.ErrNoRows {
-			ctx.JSON(http.StatusNotFound, errorResponse(err))
-		}
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		ctx.JSON(cond.errorResponse(err))
 		return
 	}
 
@@ -191,65 +301,203 @@ func (server *Server) deleteCustomer(ctx *gin.Context) {
 
 }
 
+// restoreCustomer godoc
+// @Summary restore a deleted Customer
+// @Description restore a deleted Customer with the Cars deleted along with it. A Customer that is not deleted is a 409.
+// @Tags Customer
+// @ID restore-Customer
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id of the deleted Customer"
+// @Success 200 {object} CustomerResponse
+// @Header 200 {string} ETag "The version of the Customer, to send in If-Match"
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /customers/{id}/restore [post]
+func (server *Server) restoreCustomer(ctx *gin.Context) {
+	var req getCustomerRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	customer, err := server.store.RestoreCustomerTx(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	setETag(ctx, customer.Version)
+	ctx.JSON(http.StatusOK, newCustomerResponse(customer))
+}
+
+// purgeCustomer godoc
+// @Summary purge a Customer
+// @Description delete a Customer, deleted or not, for good with its Cars and their Service Orders. A Customer with invoices cannot be purged.
+// @Tags Customer
+// @ID purge-Customer
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id of the Customer to purge"
+// @Success 204 string purged
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /customers/{id}/purge [post]
+func (server *Server) purgeCustomer(ctx *gin.Context) {
+	var req getCustomerRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	if err := server.store.PurgeCustomerTx(ctx, req.ID); err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, "purged")
+}
+
 // swagger:model updateCustomerRequest
 type updateCustomerRequest struct {
 	// The Name of a Customer
 	// example: Karim Stam
-	FullName string `json:"fullName"`
-	// The PhoneNumber for a Customer
-	// example: +2131122334455
-	PhoneNumber string `json:"phoneNumber"`
+	FullName string `json:"fullName" binding:"required"`
+	// The PhoneNumber for a Customer, normalized to E.164
+	// example: +213550123456
+	PhoneNumber string `json:"phoneNumber" binding:"required,phone"`
 }
 
 // updateCustomer godoc
-// @Summary update  Customer
-// @Description update a  Customer
+// @Summary replace  Customer
+// @Description replace all the fields of a Customer, use PATCH to change some of them
 // @Tags Customer
 // @ID update-Customer
 // @Accept  json
 // @Produce  json
-// @Param id path string true  "The id to get a Customer"
-// @Param Body body createCustomerRequest true "The body to create a Customer"
-// @Success 200 {object} CustomersResponse
+// @Param id path string true  "The id to update a Customer"
+// @Param Body body updateCustomerRequest true "The body to replace a Customer"
+// @Param If-Match header string false "The ETag of the version to change, the request fails with a 412 when the Customer changed since"
+// @Success 200 {object} CustomerResponse
+// @Header 200 {string} ETag "The version of the Customer, to send in If-Match"
 // @Failure 400 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
 // @Router /customers/{id} [put]
 func (server *Server) updateCustomer(ctx *gin.Context) {
+	var uri getCustomerRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
 	var req updateCustomerRequest
-	userID := ctx.Param("id")
-	user, err := strconv.ParseInt(userID, 10, 64)
-	if err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
 		return
 	}
-	customer, err := server.store.GetCustomer(ctx, user)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			ctx.JSON(http.StatusNotFound, errorResponse(err))
-		}
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+
+	cond, ok := bindIfMatch(ctx)
+	if !ok {
 		return
 	}
 
-	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+	phoneNumber, err := util.NormalizePhone(req.PhoneNumber, server.config.DefaultPhoneRegion)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
 		return
 	}
 
 	arg := db.UpdateCustomerParams{
-		ID:          customer.ID,
+		ID:          uri.ID,
 		FullName:    req.FullName,
-		PhoneNumber: req.PhoneNumber,
+		PhoneNumber: phoneNumber,
+		Versions:    cond.versions,
 	}
+	customer, err := server.store.UpdateCustomer(ctx, arg)
+	if err != nil {
+		ctx.JSON(cond.errorResponse(err))
+		return
+	}
+
+	setETag(ctx, customer.Version)
+	ctx.JSON(http.StatusOK, newCustomerResponse(customer))
+}
 
-	customer2, err := server.store.UpdateCustomer(ctx, arg)
+// swagger:model patchCustomerRequest
+type patchCustomerRequest struct {
+	// The new Name of the Customer, unchanged when omitted
+	// example: Karim Stam
+	FullName *string `json:"fullName" binding:"omitempty,min=1"`
+	// The new PhoneNumber of the Customer, normalized to E.164, unchanged when omitted
+	// example: +213550123456
+	PhoneNumber *string `json:"phoneNumber" binding:"omitempty,phone"`
+}
 
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+// patchCustomer godoc
+// @Summary patch  Customer
+// @Description change some fields of a Customer with a JSON merge patch (RFC 7396): the fields omitted are left unchanged, none can be removed with null
+// @Tags Customer
+// @ID patch-Customer
+// @Accept  json
+// @Accept  application/merge-patch+json
+// @Produce  json
+// @Param id path string true  "The id to patch a Customer"
+// @Param Body body patchCustomerRequest true "The fields to change"
+// @Param If-Match header string false "The ETag of the version to change, the request fails with a 412 when the Customer changed since"
+// @Success 200 {object} CustomerResponse
+// @Header 200 {string} ETag "The version of the Customer, to send in If-Match"
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /customers/{id} [patch]
+func (server *Server) patchCustomer(ctx *gin.Context) {
+	var uri getCustomerRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	var req patchCustomerRequest
+	if err := bindMergePatch(ctx, &req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	cond, ok := bindIfMatch(ctx)
+	if !ok {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, customer2)
+	arg := db.PatchCustomerParams{ID: uri.ID, Versions: cond.versions}
+	if req.FullName != nil {
+		arg.FullName = sql.NullString{String: *req.FullName, Valid: true}
+	}
+	if req.PhoneNumber != nil {
+		phoneNumber, err := util.NormalizePhone(*req.PhoneNumber, server.config.DefaultPhoneRegion)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+			return
+		}
+		arg.PhoneNumber = sql.NullString{String: phoneNumber, Valid: true}
+	}
+
+	customer, err := server.store.PatchCustomer(ctx, arg)
+	if err != nil {
+		ctx.JSON(cond.errorResponse(err))
+		return
+	}
 
+	setETag(ctx, customer.Version)
+	ctx.JSON(http.StatusOK, newCustomerResponse(customer))
 }
@@ -0,0 +1,1555 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	mockdb "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/mock"
+	db "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/sqlc"
+	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/token"
+	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/util"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCustomerAPI(t *testing.T) {
+	customer := randomCustomer()
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"fullName":    customer.FullName,
+				"phoneNumber": customer.PhoneNumber,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.CreateCustomerParams{
+					FullName:    customer.FullName,
+					PhoneNumber: customer.PhoneNumber,
+				}
+				store.EXPECT().
+					CreateCustomer(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(customer, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchCustomer(t, recorder.Body, customer)
+			},
+		},
+		{
+			name: "InternalError",
+			body: gin.H{
+				"fullName":    customer.FullName,
+				"phoneNumber": customer.PhoneNumber,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateCustomer(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Customer{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInternal)
+			},
+		},
+		{
+			name: "MissingFullName",
+			body: gin.H{
+				"phoneNumber": customer.PhoneNumber,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateCustomer(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInvalidRequest)
+			},
+		},
+		{
+			name: "NationalPhoneNumber",
+			body: gin.H{
+				"fullName":    customer.FullName,
+				"phoneNumber": "0" + customer.PhoneNumber[4:6] + " " + customer.PhoneNumber[6:9] + "-" + customer.PhoneNumber[9:],
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.CreateCustomerParams{
+					FullName:    customer.FullName,
+					PhoneNumber: customer.PhoneNumber,
+				}
+				store.EXPECT().
+					CreateCustomer(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(customer, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchCustomer(t, recorder.Body, customer)
+			},
+		},
+		{
+			name: "InvalidPhoneNumber",
+			body: gin.H{
+				"fullName":    customer.FullName,
+				"phoneNumber": "0550",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateCustomer(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInvalidRequest)
+			},
+		},
+		{
+			name: "MissingPhoneNumber",
+			body: gin.H{
+				"fullName": customer.FullName,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateCustomer(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInvalidRequest)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/customers", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, util.RandomName(), util.RoleFrontDesk, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestGetCustomerAPI(t *testing.T) {
+	customer := randomCustomer()
+
+	testCases := []struct {
+		name          string
+		customerID    int64
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:       "OK",
+			customerID: customer.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetCustomer(gomock.Any(), gomock.Eq(customer.ID)).
+					Times(1).
+					Return(customer, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, etag(customer.Version), recorder.Header().Get("ETag"))
+				requireBodyMatchCustomer(t, recorder.Body, customer)
+			},
+		},
+		{
+			name:       "NotFound",
+			customerID: customer.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetCustomer(gomock.Any(), gomock.Eq(customer.ID)).
+					Times(1).
+					Return(db.Customer{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeNotFound)
+			},
+		},
+		{
+			name:       "InternalError",
+			customerID: customer.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetCustomer(gomock.Any(), gomock.Eq(customer.ID)).
+					Times(1).
+					Return(db.Customer{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInternal)
+			},
+		},
+		{
+			name:       "InvalidID",
+			customerID: 0,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetCustomer(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInvalidRequest)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/customers/%d", tc.customerID)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, util.RandomName(), util.RoleFrontDesk, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestListCustomersAPI(t *testing.T) {
+	n := 5
+	customers := make([]db.Customer, n+1)
+	for i := 0; i <= n; i++ {
+		customers[i] = randomCustomer()
+		customers[i].ID = int64(i + 1)
+	}
+	cursor := encodeCursor(pageCursor{AfterID: customers[n-1].ID})
+
+	type Query struct {
+		cursor   string
+		pageSize int
+		params   map[string]string
+	}
+
+	testCases := []struct {
+		name          string
+		query         Query
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			query: Query{
+				pageSize: n,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListParams{
+					Limit: int32(n + 1),
+				}
+				store.EXPECT().
+					ListCustomersWhere(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(customers, nil)
+				store.EXPECT().
+					CountCustomersWhere(gomock.Any(), gomock.Nil()).
+					Times(1).
+					Return(int64(n+1), nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchCustomerPage(t, recorder.Body, customers[:n], cursor, int64(n+1))
+			},
+		},
+		{
+			name: "LastPage",
+			query: Query{
+				cursor:   cursor,
+				pageSize: n,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListParams{
+					After: &db.Keyset{ID: customers[n-1].ID},
+					Limit: int32(n + 1),
+				}
+				store.EXPECT().
+					ListCustomersWhere(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(customers[n:], nil)
+				store.EXPECT().
+					CountCustomersWhere(gomock.Any(), gomock.Nil()).
+					Times(1).
+					Return(int64(n+1), nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchCustomerPage(t, recorder.Body, customers[n:], "", int64(n+1))
+			},
+		},
+		{
+			name:  "DefaultPageSize",
+			query: Query{},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListParams{
+					Limit: defaultPageSize + 1,
+				}
+				store.EXPECT().
+					ListCustomersWhere(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(customers, nil)
+				store.EXPECT().
+					CountCustomersWhere(gomock.Any(), gomock.Nil()).
+					Times(1).
+					Return(int64(n+1), nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchCustomerPage(t, recorder.Body, customers, "", int64(n+1))
+			},
+		},
+		{
+			name: "InternalError",
+			query: Query{
+				pageSize: n,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListCustomersWhere(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.Customer{}, sql.ErrConnDone)
+				store.EXPECT().
+					CountCustomersWhere(gomock.Any(), gomock.Nil()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInternal)
+			},
+		},
+		{
+			name: "CountError",
+			query: Query{
+				pageSize: n,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListCustomersWhere(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(customers, nil)
+				store.EXPECT().
+					CountCustomersWhere(gomock.Any(), gomock.Nil()).
+					Times(1).
+					Return(int64(0), sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInternal)
+			},
+		},
+		{
+			name: "SortAndFilter",
+			query: Query{
+				pageSize: n,
+				params: map[string]string{
+					"sort":           "-full_name",
+					"name_prefix":    "ka",
+					"created_after":  "2022-01-01",
+					"created_before": "2022-02-01T10:00:00+01:00",
+				},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				where := []db.Condition{
+					{Column: "created_at", Op: db.OpGte, Value: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+					{Column: "created_at", Op: db.OpLt, Value: time.Date(2022, 2, 1, 9, 0, 0, 0, time.UTC)},
+					{Column: "full_name", Op: db.OpPrefix, Value: "ka"},
+				}
+				arg := db.ListParams{
+					Where:      where,
+					SortColumn: "full_name",
+					Desc:       true,
+					Limit:      int32(n + 1),
+				}
+				store.EXPECT().
+					ListCustomersWhere(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(customers, nil)
+				store.EXPECT().
+					CountCustomersWhere(gomock.Any(), gomock.Eq(where)).
+					Times(1).
+					Return(int64(n+1), nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				next := encodeCursor(pageCursor{Sort: "-full_name", AfterValue: customers[n-1].FullName, AfterID: customers[n-1].ID})
+				requireBodyMatchCustomerPage(t, recorder.Body, customers[:n], next, int64(n+1))
+			},
+		},
+		{
+			name: "UnknownFilter",
+			query: Query{
+				params: map[string]string{"phone_number": "+213555000000"},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListCustomersWhere(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInvalidRequest)
+			},
+		},
+		{
+			name: "UnknownSortField",
+			query: Query{
+				params: map[string]string{"sort": "phone_number"},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListCustomersWhere(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInvalidRequest)
+			},
+		},
+		{
+			name: "InvalidCursor",
+			query: Query{
+				cursor:   "not a cursor",
+				pageSize: n,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListCustomersWhere(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInvalidRequest)
+			},
+		},
+		{
+			name: "InvalidPageSize",
+			query: Query{
+				pageSize: 100000,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListCustomersWhere(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInvalidRequest)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/customers", nil)
+			require.NoError(t, err)
+
+			q := request.URL.Query()
+			if tc.query.cursor != "" {
+				q.Add("cursor", tc.query.cursor)
+			}
+			if tc.query.pageSize != 0 {
+				q.Add("page_size", fmt.Sprintf("%d", tc.query.pageSize))
+			}
+			for key, value := range tc.query.params {
+				q.Add(key, value)
+			}
+			request.URL.RawQuery = q.Encode()
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, util.RandomName(), util.RoleFrontDesk, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestSearchCustomersAPI(t *testing.T) {
+	customers := []db.Customer{randomCustomer(), randomCustomer()}
+
+	testCases := []struct {
+		name          string
+		query         map[string]string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK",
+			query: map[string]string{"q": " Karim "},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.SearchCustomersParams{
+					NamePattern: "%Karim%",
+					Digits:      "",
+					Query:       "Karim",
+					Limit:       10,
+				}
+				store.EXPECT().
+					SearchCustomers(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(customers, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchCustomers(t, recorder.Body, customers)
+			},
+		},
+		{
+			name:  "PhoneNumber",
+			query: map[string]string{"q": "0550-12 34", "limit": "5"},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.SearchCustomersParams{
+					NamePattern: "%0550-12 34%",
+					Digits:      "5501234",
+					Query:       "0550-12 34",
+					Limit:       5,
+				}
+				store.EXPECT().
+					SearchCustomers(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(customers[:1], nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchCustomers(t, recorder.Body, customers[:1])
+			},
+		},
+		{
+			name:  "EscapedWildcards",
+			query: map[string]string{"q": `a_b%c\`},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.SearchCustomersParams{
+					NamePattern: `%a\_b\%c\\%`,
+					Digits:      "",
+					Query:       `a_b%c\`,
+					Limit:       10,
+				}
+				store.EXPECT().
+					SearchCustomers(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return([]db.Customer{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchCustomers(t, recorder.Body, []db.Customer{})
+			},
+		},
+		{
+			name:  "InternalError",
+			query: map[string]string{"q": "Karim"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchCustomers(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.Customer{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInternal)
+			},
+		},
+		{
+			name:  "MissingQuery",
+			query: map[string]string{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchCustomers(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInvalidRequest)
+			},
+		},
+		{
+			name:  "QueryTooShort",
+			query: map[string]string{"q": "K"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchCustomers(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInvalidRequest)
+			},
+		},
+		{
+			name:  "InvalidLimit",
+			query: map[string]string{"q": "Karim", "limit": "1000"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchCustomers(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInvalidRequest)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/customers/search", nil)
+			require.NoError(t, err)
+
+			q := request.URL.Query()
+			for key, value := range tc.query {
+				q.Add(key, value)
+			}
+			request.URL.RawQuery = q.Encode()
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, util.RandomName(), util.RoleMechanic, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestUpdateCustomerAPI(t *testing.T) {
+	customer := randomCustomer()
+	updated := customer
+	updated.FullName = util.RandomName()
+	updated.PhoneNumber = util.RandomPhone()
+
+	testCases := []struct {
+		name          string
+		customerID    string
+		ifMatch       string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:       "OK",
+			customerID: fmt.Sprint(customer.ID),
+			body: gin.H{
+				"fullName":    updated.FullName,
+				"phoneNumber": updated.PhoneNumber,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.UpdateCustomerParams{
+					ID:          customer.ID,
+					FullName:    updated.FullName,
+					PhoneNumber: updated.PhoneNumber,
+				}
+				store.EXPECT().
+					UpdateCustomer(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(updated, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, etag(updated.Version), recorder.Header().Get("ETag"))
+				requireBodyMatchCustomer(t, recorder.Body, updated)
+			},
+		},
+		{
+			name:       "IfMatch",
+			customerID: fmt.Sprint(customer.ID),
+			ifMatch:    etag(customer.Version),
+			body: gin.H{
+				"fullName":    updated.FullName,
+				"phoneNumber": updated.PhoneNumber,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.UpdateCustomerParams{
+					ID:          customer.ID,
+					FullName:    updated.FullName,
+					PhoneNumber: updated.PhoneNumber,
+					Versions:    []int32{customer.Version},
+				}
+				store.EXPECT().
+					UpdateCustomer(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(updated, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchCustomer(t, recorder.Body, updated)
+			},
+		},
+		{
+			name:       "PreconditionFailed",
+			customerID: fmt.Sprint(customer.ID),
+			ifMatch:    etag(customer.Version),
+			body: gin.H{
+				"fullName":    updated.FullName,
+				"phoneNumber": updated.PhoneNumber,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateCustomer(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Customer{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusPreconditionFailed, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codePreconditionFailed)
+			},
+		},
+		{
+			name:       "InvalidIfMatch",
+			customerID: fmt.Sprint(customer.ID),
+			ifMatch:    "3",
+			body: gin.H{
+				"fullName":    updated.FullName,
+				"phoneNumber": updated.PhoneNumber,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateCustomer(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInvalidRequest)
+			},
+		},
+		{
+			name:       "NotFound",
+			customerID: fmt.Sprint(customer.ID),
+			body: gin.H{
+				"fullName":    updated.FullName,
+				"phoneNumber": updated.PhoneNumber,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateCustomer(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Customer{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeNotFound)
+			},
+		},
+		{
+			name:       "InternalError",
+			customerID: fmt.Sprint(customer.ID),
+			body: gin.H{
+				"fullName":    updated.FullName,
+				"phoneNumber": updated.PhoneNumber,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateCustomer(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Customer{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInternal)
+			},
+		},
+		{
+			name:       "InvalidID",
+			customerID: "abc",
+			body: gin.H{
+				"fullName":    updated.FullName,
+				"phoneNumber": updated.PhoneNumber,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateCustomer(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInvalidRequest)
+			},
+		},
+		{
+			name:       "InvalidBody",
+			customerID: fmt.Sprint(customer.ID),
+			body: gin.H{
+				"fullName": 42,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateCustomer(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInvalidRequest)
+			},
+		},
+		{
+			name:       "MissingPhoneNumber",
+			customerID: fmt.Sprint(customer.ID),
+			body: gin.H{
+				"fullName": updated.FullName,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateCustomer(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInvalidRequest)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/customers/%s", tc.customerID)
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+			if tc.ifMatch != "" {
+				request.Header.Set("If-Match", tc.ifMatch)
+			}
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, util.RandomName(), util.RoleFrontDesk, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestPatchCustomerAPI(t *testing.T) {
+	customer := randomCustomer()
+	updated := customer
+	updated.FullName = util.RandomName()
+
+	testCases := []struct {
+		name          string
+		customerID    string
+		ifMatch       string
+		body          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:       "OK",
+			customerID: fmt.Sprint(customer.ID),
+			body:       fmt.Sprintf(`{"fullName": %q}`, updated.FullName),
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.PatchCustomerParams{
+					ID:       customer.ID,
+					FullName: sql.NullString{String: updated.FullName, Valid: true},
+				}
+				store.EXPECT().
+					PatchCustomer(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(updated, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchCustomer(t, recorder.Body, updated)
+			},
+		},
+		{
+			name:       "NationalPhoneNumber",
+			customerID: fmt.Sprint(customer.ID),
+			body:       `{"phoneNumber": "0550 12 34 56"}`,
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.PatchCustomerParams{
+					ID:          customer.ID,
+					PhoneNumber: sql.NullString{String: "+213550123456", Valid: true},
+				}
+				store.EXPECT().
+					PatchCustomer(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(customer, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:       "EmptyPatch",
+			customerID: fmt.Sprint(customer.ID),
+			body:       `{}`,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					PatchCustomer(gomock.Any(), gomock.Eq(db.PatchCustomerParams{ID: customer.ID})).
+					Times(1).
+					Return(customer, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchCustomer(t, recorder.Body, customer)
+			},
+		},
+		{
+			name:       "NotFound",
+			customerID: fmt.Sprint(customer.ID),
+			body:       fmt.Sprintf(`{"fullName": %q}`, updated.FullName),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					PatchCustomer(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Customer{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeNotFound)
+			},
+		},
+		{
+			name:       "InternalError",
+			customerID: fmt.Sprint(customer.ID),
+			body:       fmt.Sprintf(`{"fullName": %q}`, updated.FullName),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					PatchCustomer(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Customer{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInternal)
+			},
+		},
+		{
+			name:       "PreconditionFailed",
+			customerID: fmt.Sprint(customer.ID),
+			ifMatch:    `W/"1", "2"`,
+			body:       fmt.Sprintf(`{"fullName": %q}`, updated.FullName),
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.PatchCustomerParams{
+					ID:       customer.ID,
+					FullName: sql.NullString{String: updated.FullName, Valid: true},
+					Versions: []int32{2},
+				}
+				store.EXPECT().
+					PatchCustomer(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.Customer{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusPreconditionFailed, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codePreconditionFailed)
+			},
+		},
+		{
+			name:       "NullMember",
+			customerID: fmt.Sprint(customer.ID),
+			body:       `{"phoneNumber": null}`,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					PatchCustomer(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInvalidRequest)
+			},
+		},
+		{
+			name:       "NotAnObject",
+			customerID: fmt.Sprint(customer.ID),
+			body:       `["fullName"]`,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					PatchCustomer(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInvalidRequest)
+			},
+		},
+		{
+			name:       "EmptyFullName",
+			customerID: fmt.Sprint(customer.ID),
+			body:       `{"fullName": ""}`,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					PatchCustomer(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInvalidRequest)
+			},
+		},
+		{
+			name:       "InvalidPhoneNumber",
+			customerID: fmt.Sprint(customer.ID),
+			body:       `{"phoneNumber": "12"}`,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					PatchCustomer(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInvalidRequest)
+			},
+		},
+		{
+			name:       "InvalidID",
+			customerID: "abc",
+			body:       fmt.Sprintf(`{"fullName": %q}`, updated.FullName),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					PatchCustomer(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInvalidRequest)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/customers/%s", tc.customerID)
+			request, err := http.NewRequest(http.MethodPatch, url, strings.NewReader(tc.body))
+			require.NoError(t, err)
+			request.Header.Set("Content-Type", "application/merge-patch+json")
+			if tc.ifMatch != "" {
+				request.Header.Set("If-Match", tc.ifMatch)
+			}
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, util.RandomName(), util.RoleFrontDesk, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestDeleteCustomerAPI(t *testing.T) {
+	customer := randomCustomer()
+
+	testCases := []struct {
+		name          string
+		customerID    int64
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:       "OK",
+			customerID: customer.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, util.RandomName(), util.RoleFrontDesk, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteCustomerTx(gomock.Any(), gomock.Eq(db.SoftDeleteCustomerParams{ID: customer.ID})).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name:       "NotFound",
+			customerID: customer.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, util.RandomName(), util.RoleFrontDesk, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteCustomerTx(gomock.Any(), gomock.Eq(db.SoftDeleteCustomerParams{ID: customer.ID})).
+					Times(1).
+					Return(sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeNotFound)
+			},
+		},
+		{
+			name:       "PreconditionFailed",
+			customerID: customer.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, util.RandomName(), util.RoleFrontDesk, time.Minute)
+				request.Header.Set("If-Match", etag(customer.Version))
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.SoftDeleteCustomerParams{ID: customer.ID, Versions: []int32{customer.Version}}
+				store.EXPECT().
+					DeleteCustomerTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusPreconditionFailed, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codePreconditionFailed)
+			},
+		},
+		{
+			name:       "InternalError",
+			customerID: customer.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, util.RandomName(), util.RoleFrontDesk, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteCustomerTx(gomock.Any(), gomock.Eq(db.SoftDeleteCustomerParams{ID: customer.ID})).
+					Times(1).
+					Return(sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInternal)
+			},
+		},
+		{
+			name:       "NoAuthorization",
+			customerID: customer.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteCustomerTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeUnauthorized)
+			},
+		},
+		{
+			name:       "MechanicForbidden",
+			customerID: customer.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, util.RandomName(), util.RoleMechanic, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteCustomerTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeForbidden)
+			},
+		},
+		{
+			name:       "InvalidID",
+			customerID: 0,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, util.RandomName(), util.RoleFrontDesk, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteCustomerTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInvalidRequest)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/customers/%d", tc.customerID)
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request, server.tokenMaker)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestRestoreCustomerAPI(t *testing.T) {
+	customer := randomCustomer()
+
+	testCases := []struct {
+		name          string
+		customerID    int64
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:       "OK",
+			customerID: customer.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, util.RandomName(), util.RoleFrontDesk, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					RestoreCustomerTx(gomock.Any(), gomock.Eq(customer.ID)).
+					Times(1).
+					Return(customer, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, etag(customer.Version), recorder.Header().Get("ETag"))
+				requireBodyMatchCustomer(t, recorder.Body, customer)
+			},
+		},
+		{
+			name:       "NotFound",
+			customerID: customer.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, util.RandomName(), util.RoleFrontDesk, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					RestoreCustomerTx(gomock.Any(), gomock.Eq(customer.ID)).
+					Times(1).
+					Return(db.Customer{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeNotFound)
+			},
+		},
+		{
+			name:       "NotDeleted",
+			customerID: customer.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, util.RandomName(), util.RoleFrontDesk, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					RestoreCustomerTx(gomock.Any(), gomock.Eq(customer.ID)).
+					Times(1).
+					Return(db.Customer{}, &db.ConflictError{})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+				require.Contains(t, recorder.Body.String(), codeConflict)
+			},
+		},
+		{
+			name:       "PhoneNumberTaken",
+			customerID: customer.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, util.RandomName(), util.RoleFrontDesk, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					RestoreCustomerTx(gomock.Any(), gomock.Eq(customer.ID)).
+					Times(1).
+					Return(db.Customer{}, &pq.Error{Code: "23505"})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeUniqueViolation)
+			},
+		},
+		{
+			name:       "MechanicForbidden",
+			customerID: customer.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, util.RandomName(), util.RoleMechanic, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					RestoreCustomerTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeForbidden)
+			},
+		},
+		{
+			name:       "InvalidID",
+			customerID: 0,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, util.RandomName(), util.RoleFrontDesk, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					RestoreCustomerTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInvalidRequest)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/customers/%d/restore", tc.customerID)
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request, server.tokenMaker)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestPurgeCustomerAPI(t *testing.T) {
+	customer := randomCustomer()
+
+	testCases := []struct {
+		name          string
+		customerID    int64
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:       "OK",
+			customerID: customer.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, util.RandomName(), util.RoleAdmin, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					PurgeCustomerTx(gomock.Any(), gomock.Eq(customer.ID)).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name:       "Invoiced",
+			customerID: customer.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, util.RandomName(), util.RoleAdmin, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					PurgeCustomerTx(gomock.Any(), gomock.Eq(customer.ID)).
+					Times(1).
+					Return(&db.ConflictError{})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+				require.Contains(t, recorder.Body.String(), codeConflict)
+			},
+		},
+		{
+			name:       "NotFound",
+			customerID: customer.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, util.RandomName(), util.RoleAdmin, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					PurgeCustomerTx(gomock.Any(), gomock.Eq(customer.ID)).
+					Times(1).
+					Return(sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeNotFound)
+			},
+		},
+		{
+			name:       "FrontDeskForbidden",
+			customerID: customer.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, util.RandomName(), util.RoleFrontDesk, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					PurgeCustomerTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeForbidden)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/customers/%d/purge", tc.customerID)
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request, server.tokenMaker)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func randomCustomer() db.Customer {
+	return db.Customer{
+		ID:          util.RandomInt(1, 1000),
+		FullName:    util.RandomName(),
+		PhoneNumber: util.RandomPhone(),
+		CreatedAt:   time.Now().UTC().Truncate(time.Second),
+		Version:     int32(util.RandomInt(1, 10)),
+	}
+}
+
+func requireBodyMatchCustomer(t *testing.T, body *bytes.Buffer, customer db.Customer) {
+	data, err := ioutil.ReadAll(body)
+	require.NoError(t, err)
+
+	var gotCustomer CustomerResponse
+	err = json.Unmarshal(data, &gotCustomer)
+	require.NoError(t, err)
+	require.Equal(t, newCustomerResponse(customer), gotCustomer)
+}
+
+func requireBodyMatchCustomers(t *testing.T, body *bytes.Buffer, customers []db.Customer) {
+	data, err := ioutil.ReadAll(body)
+	require.NoError(t, err)
+
+	var gotCustomers []CustomerResponse
+	err = json.Unmarshal(data, &gotCustomers)
+	require.NoError(t, err)
+	require.Equal(t, newCustomersResponse(customers), gotCustomers)
+}
+
+func requireBodyMatchCustomerPage(t *testing.T, body *bytes.Buffer, customers []db.Customer, nextCursor string, totalCount int64) {
+	data, err := ioutil.ReadAll(body)
+	require.NoError(t, err)
+
+	var gotPage struct {
+		Items      []CustomerResponse `json:"items"`
+		NextCursor string             `json:"next_cursor"`
+		TotalCount int64              `json:"total_count"`
+	}
+	err = json.Unmarshal(data, &gotPage)
+	require.NoError(t, err)
+	require.Equal(t, newCustomersResponse(customers), gotPage.Items)
+	require.Equal(t, nextCursor, gotPage.NextCursor)
+	require.Equal(t, totalCount, gotPage.TotalCount)
+}
+
+func requireBodyMatchError(t *testing.T, body *bytes.Buffer, code string) {
+	data, err := ioutil.ReadAll(body)
+	require.NoError(t, err)
+
+	var gotError ErrorResponse
+	err = json.Unmarshal(data, &gotError)
+	require.NoError(t, err)
+	require.Equal(t, code, gotError.Code)
+	require.NotEmpty(t, gotError.Error)
+}
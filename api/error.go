@@ -0,0 +1,72 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	db "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/sqlc"
+	"github.com/lib/pq"
+)
+
+// The machine-readable codes of ErrorResponse
+const (
+	codeInvalidRequest      = "invalid_request"
+	codeUnauthorized        = "unauthorized"
+	codeForbidden           = "forbidden"
+	codeNotFound            = "not_found"
+	codeConflict            = "conflict"
+	codePreconditionFailed  = "precondition_failed"
+	codeUniqueViolation     = "unique_violation"
+	codeForeignKeyViolation = "foreign_key_violation"
+	codeCheckViolation      = "check_violation"
+	codeNotNullViolation    = "not_null_violation"
+	codeInternal            = "internal"
+)
+
+// swagger:model ErrorResponse
+type ErrorResponse struct {
+	// A machine-readable code of the error
+	// example: not_found
+	Code string `json:"code"`
+	// A description of the error
+	// example: sql: no rows in result set
+	Error string `json:"error"`
+}
+
+func errorResponse(code string, err error) ErrorResponse {
+	return ErrorResponse{
+		Code:  code,
+		Error: err.Error(),
+	}
+}
+
+// dbErrorResponse maps an error returned by the store to its HTTP status and ErrorResponse:
+// a missing row is a 404, a conflict with the state of the data or a duplicate is a 409, a
+// reference to a missing row or a value refused by a constraint is a 422, anything else is a 500
+func dbErrorResponse(err error) (int, ErrorResponse) {
+	if errors.Is(err, sql.ErrNoRows) {
+		return http.StatusNotFound, errorResponse(codeNotFound, err)
+	}
+
+	var conflict *db.ConflictError
+	if errors.As(err, &conflict) {
+		return http.StatusConflict, errorResponse(codeConflict, err)
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code.Name() {
+		case codeUniqueViolation:
+			return http.StatusConflict, errorResponse(codeUniqueViolation, err)
+		case codeForeignKeyViolation:
+			return http.StatusUnprocessableEntity, errorResponse(codeForeignKeyViolation, err)
+		case codeCheckViolation:
+			return http.StatusUnprocessableEntity, errorResponse(codeCheckViolation, err)
+		case codeNotNullViolation:
+			return http.StatusUnprocessableEntity, errorResponse(codeNotNullViolation, err)
+		}
+	}
+
+	return http.StatusInternalServerError, errorResponse(codeInternal, err)
+}
@@ -0,0 +1,42 @@
+package api
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"testing"
+
+	db "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/sqlc"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDBErrorResponse(t *testing.T) {
+	testCases := []struct {
+		name   string
+		err    error
+		status int
+		code   string
+	}{
+		{"NoRows", sql.ErrNoRows, http.StatusNotFound, codeNotFound},
+		{"WrappedNoRows", fmt.Errorf("tx err: %w", sql.ErrNoRows), http.StatusNotFound, codeNotFound},
+		{"Conflict", &db.ConflictError{}, http.StatusConflict, codeConflict},
+		{"UniqueViolation", &pq.Error{Code: "23505"}, http.StatusConflict, codeUniqueViolation},
+		{"ForeignKeyViolation", &pq.Error{Code: "23503"}, http.StatusUnprocessableEntity, codeForeignKeyViolation},
+		{"CheckViolation", &pq.Error{Code: "23514"}, http.StatusUnprocessableEntity, codeCheckViolation},
+		{"NotNullViolation", &pq.Error{Code: "23502"}, http.StatusUnprocessableEntity, codeNotNullViolation},
+		{"OtherPqError", &pq.Error{Code: "40001"}, http.StatusInternalServerError, codeInternal},
+		{"Internal", sql.ErrConnDone, http.StatusInternalServerError, codeInternal},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			status, rsp := dbErrorResponse(tc.err)
+			require.Equal(t, tc.status, status)
+			require.Equal(t, tc.code, rsp.Code)
+			require.Equal(t, tc.err.Error(), rsp.Error)
+		})
+	}
+}
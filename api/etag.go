@@ -0,0 +1,82 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	errInvalidIfMatch  = errors.New("invalid If-Match header")
+	errVersionMismatch = errors.New("the resource was changed or deleted since it was read, get it again")
+)
+
+// etag is the entity tag of a version of a customer, a car or a service order
+func etag(version int32) string {
+	return `"` + strconv.Itoa(int(version)) + `"`
+}
+
+// setETag sets the ETag header of a response to the version of the resource it returns,
+// the client sends it back in If-Match to change or delete that version only
+func setETag(ctx *gin.Context, version int32) {
+	ctx.Header("ETag", etag(version))
+}
+
+// ifMatch is the If-Match header of a request
+type ifMatch struct {
+	present bool
+	// The versions the entity tags of the header stand for, nil for "*" which matches any.
+	// It is passed as the Versions of the queries, which ignore a nil one.
+	versions []int32
+}
+
+// parseIfMatch reads an If-Match header. The weak tags and the tags that are not ours
+// never match, as If-Match uses the strong comparison.
+func parseIfMatch(header string) (ifMatch, error) {
+	cond := ifMatch{present: strings.TrimSpace(header) != ""}
+	if !cond.present {
+		return cond, nil
+	}
+	if strings.TrimSpace(header) == "*" {
+		return cond, nil
+	}
+
+	cond.versions = []int32{}
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		weak := strings.HasPrefix(tag, "W/")
+		tag = strings.TrimPrefix(tag, "W/")
+		if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+			return cond, errInvalidIfMatch
+		}
+		version, err := strconv.ParseInt(tag[1:len(tag)-1], 10, 32)
+		if weak || err != nil {
+			continue
+		}
+		cond.versions = append(cond.versions, int32(version))
+	}
+	return cond, nil
+}
+
+// bindIfMatch reads the If-Match header of a request, answering a malformed one with a 400
+func bindIfMatch(ctx *gin.Context) (ifMatch, bool) {
+	cond, err := parseIfMatch(ctx.GetHeader("If-Match"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return cond, false
+	}
+	return cond, true
+}
+
+// errorResponse maps the error of a conditional update: with an If-Match, no row means no
+// version of the resource matched, which is a 412 even when it no longer exists
+func (cond ifMatch) errorResponse(err error) (int, ErrorResponse) {
+	if cond.present && errors.Is(err, sql.ErrNoRows) {
+		return http.StatusPreconditionFailed, errorResponse(codePreconditionFailed, errVersionMismatch)
+	}
+	return dbErrorResponse(err)
+}
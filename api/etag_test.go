@@ -0,0 +1,31 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIfMatch(t *testing.T) {
+	testCases := []struct {
+		header string
+		cond   ifMatch
+	}{
+		{header: "", cond: ifMatch{}},
+		{header: "*", cond: ifMatch{present: true}},
+		{header: etag(3), cond: ifMatch{present: true, versions: []int32{3}}},
+		{header: `"3", "5"`, cond: ifMatch{present: true, versions: []int32{3, 5}}},
+		// weak tags and tags we never hand out cannot match
+		{header: `W/"3", "abc"`, cond: ifMatch{present: true, versions: []int32{}}},
+	}
+	for _, tc := range testCases {
+		cond, err := parseIfMatch(tc.header)
+		require.NoError(t, err, tc.header)
+		require.Equal(t, tc.cond, cond, tc.header)
+	}
+
+	for _, invalid := range []string{"3", `"3`, `"3", 4`} {
+		_, err := parseIfMatch(invalid)
+		require.EqualError(t, err, errInvalidIfMatch.Error(), invalid)
+	}
+}
@@ -0,0 +1,246 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	db "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/sqlc"
+	"github.com/gin-gonic/gin"
+)
+
+// swagger:model LaborEntryResponse
+type LaborEntryResponse struct {
+	// The ID of the entry
+	// example: 1
+	ID int32 `json:"id"`
+	// The ID of the Mechanic who worked
+	// example: 1
+	MechanicID int32 `json:"mechanic_id"`
+	// The ID of the Service line worked on
+	// example: 1
+	ServiceDetailID int32 `json:"service_detail_id"`
+	// When the Mechanic clocked in
+	// example: 2022-06-01T09:30:00Z
+	ClockedInAt time.Time `json:"clocked_in_at"`
+	// When the Mechanic clocked out, empty while clocked in
+	// example: 2022-06-01T10:15:00Z
+	ClockedOutAt *time.Time `json:"clocked_out_at"`
+}
+
+func newLaborEntryResponse(entry db.LaborEntry) LaborEntryResponse {
+	return LaborEntryResponse{
+		ID:              entry.ID,
+		MechanicID:      entry.MechanicID,
+		ServiceDetailID: entry.ServiceDetailID,
+		ClockedInAt:     entry.ClockedInAt,
+		ClockedOutAt:    nullTime(entry.ClockedOutAt),
+	}
+}
+
+// swagger:model clockInRequest
+type clockInRequest struct {
+	// The ID of the Service Order worked on
+	// example: 1
+	ServiceOrderID int32 `json:"serviceOrderId" binding:"required,min=1"`
+	// The ID of the Service of the line worked on
+	// example: 1
+	ServiceID int32 `json:"serviceId" binding:"required,min=1"`
+}
+
+// clockIn godoc
+// @Summary clock a Mechanic in on a Service line
+// @Description start the time clock of a Mechanic on a Service line of an open Service Order, a pending or paused line is started.
+// @Description A Mechanic is clocked in on one line at a time.
+// @Tags Mechanic
+// @ID clock-in-Mechanic
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id of the Mechanic"
+// @Param Body body clockInRequest true "The Service line worked on"
+// @Success 200 {object} LaborEntryResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /mechanics/{id}/clock-in [post]
+func (server *Server) clockIn(ctx *gin.Context) {
+	var uri getMechanicRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	var req clockInRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	arg := db.ClockInTxParams{
+		MechanicID:     uri.ID,
+		ServiceOrderID: req.ServiceOrderID,
+		ServiceID:      req.ServiceID,
+	}
+	entry, err := server.store.ClockInTx(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newLaborEntryResponse(entry))
+}
+
+// clockOut godoc
+// @Summary clock a Mechanic out
+// @Description stop the time clock of a Mechanic, the Service line stays started until it is paused or done
+// @Tags Mechanic
+// @ID clock-out-Mechanic
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id of the Mechanic"
+// @Success 200 {object} LaborEntryResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /mechanics/{id}/clock-out [post]
+func (server *Server) clockOut(ctx *gin.Context) {
+	var req getMechanicRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	entry, err := server.store.ClockOutMechanic(ctx, req.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err := fmt.Errorf("mechanic %d is not clocked in", req.ID)
+			ctx.JSON(http.StatusConflict, errorResponse(codeConflict, err))
+			return
+		}
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newLaborEntryResponse(entry))
+}
+
+// listServiceOrderLabor godoc
+// @Summary list the time worked on a Service Order
+// @Description GET the time clock entries of the Mechanics on the Service lines of a Service Order
+// @Tags Mechanic
+// @ID list-LaborEntry
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id of the Service Order"
+// @Success 200 {array} LaborEntryResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /service-orders/{id}/labor [get]
+func (server *Server) listServiceOrderLabor(ctx *gin.Context) {
+	var req getServiceOrderRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	entries, err := server.store.ListServiceOrderLaborEntries(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	rsp := make([]LaborEntryResponse, 0, len(entries))
+	for _, entry := range entries {
+		rsp = append(rsp, newLaborEntryResponse(entry))
+	}
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+// swagger:model ServiceLaborReportResponse
+type ServiceLaborReportResponse struct {
+	// The ID of the Service
+	// example: 1
+	ServiceID int32 `json:"service_id"`
+	// The Name of the Service
+	// example: oil change
+	Name string `json:"name"`
+	// The number of lines of the Service done in the period
+	// example: 12
+	Lines int64 `json:"lines"`
+	// The hours estimated for the lines, from the estimation time of the Service
+	// example: 9
+	EstimatedHours float64 `json:"estimated_hours"`
+	// The hours the Mechanics clocked on the lines
+	// example: 11.5
+	ActualHours float64 `json:"actual_hours"`
+	// The hours worked beyond the estimate, negative when the lines took less
+	// example: 2.5
+	OverrunHours float64 `json:"overrun_hours"`
+}
+
+// hours converts minutes to hours, rounded to the hundredth
+func hours(minutes int64) float64 {
+	return math.Round(float64(minutes)/60*100) / 100
+}
+
+func newServiceLaborReportResponse(row db.ListServiceLaborReportRow) ServiceLaborReportResponse {
+	return ServiceLaborReportResponse{
+		ServiceID:      row.ServiceID,
+		Name:           row.Name,
+		Lines:          row.Lines,
+		EstimatedHours: hours(row.EstimatedMinutes),
+		ActualHours:    hours(row.ActualMinutes),
+		OverrunHours:   hours(row.ActualMinutes - row.EstimatedMinutes),
+	}
+}
+
+type laborReportRequest struct {
+	From time.Time `form:"from" binding:"required" time_format:"2006-01-02" time_utc:"1"`
+	To   time.Time `form:"to" binding:"required,gtefield=From" time_format:"2006-01-02" time_utc:"1"`
+}
+
+// getLaborReport godoc
+// @Summary compare the hours worked with the estimates
+// @Description GET, for every Service with lines done in a period, the hours the Mechanics clocked on them and the hours estimated by the catalog, the most underquoted Services first
+// @Tags Service
+// @ID labor-report
+// @Accept  json
+// @Produce  json
+// @Param from query string true "The first day of the period, as 2006-01-02"
+// @Param to query string true "The last day of the period, as 2006-01-02"
+// @Success 200 {array} ServiceLaborReportResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /reports/labor [get]
+func (server *Server) getLaborReport(ctx *gin.Context) {
+	var req laborReportRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	arg := db.ListServiceLaborReportParams{
+		DoneState: db.ServiceDetailDone,
+		FromTime:  req.From,
+		ToTime:    req.To.AddDate(0, 0, 1),
+	}
+	rows, err := server.store.ListServiceLaborReport(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	rsp := make([]ServiceLaborReportResponse, 0, len(rows))
+	for _, row := range rows {
+		rsp = append(rsp, newServiceLaborReportResponse(row))
+	}
+	ctx.JSON(http.StatusOK, rsp)
+}
@@ -0,0 +1,215 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockdb "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/mock"
+	db "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/sqlc"
+	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClockOutAPI(t *testing.T) {
+	mechanicID := int32(util.RandomInt(1, 1000))
+	entry := db.LaborEntry{
+		ID:              int32(util.RandomInt(1, 1000)),
+		MechanicID:      mechanicID,
+		ServiceDetailID: int32(util.RandomInt(1, 1000)),
+		ClockedInAt:     time.Now().Add(-time.Hour).UTC().Truncate(time.Second),
+		ClockedOutAt:    sql.NullTime{Time: time.Now().UTC().Truncate(time.Second), Valid: true},
+	}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ClockOutMechanic(gomock.Any(), gomock.Eq(mechanicID)).
+					Times(1).
+					Return(entry, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got LaborEntryResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &got))
+				require.Equal(t, entry.ID, got.ID)
+				require.True(t, entry.ClockedOutAt.Time.Equal(*got.ClockedOutAt))
+			},
+		},
+		{
+			name: "NotClockedIn",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ClockOutMechanic(gomock.Any(), gomock.Eq(mechanicID)).
+					Times(1).
+					Return(db.LaborEntry{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeConflict)
+			},
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ClockOutMechanic(gomock.Any(), gomock.Eq(mechanicID)).
+					Times(1).
+					Return(db.LaborEntry{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInternal)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/mechanics/%d/clock-out", mechanicID)
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, util.RandomName(), util.RoleMechanic, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestGetLaborReportAPI(t *testing.T) {
+	row := db.ListServiceLaborReportRow{
+		ServiceID:        int32(util.RandomInt(1, 1000)),
+		Name:             util.RandomString(12),
+		EstimationTime:   45,
+		Lines:            4,
+		EstimatedMinutes: 180,
+		ActualMinutes:    250,
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		role          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK",
+			query: "from=2022-06-01&to=2022-06-30",
+			role:  util.RoleAdmin,
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListServiceLaborReportParams{
+					DoneState: db.ServiceDetailDone,
+					FromTime:  time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC),
+					ToTime:    time.Date(2022, 7, 1, 0, 0, 0, 0, time.UTC),
+				}
+				store.EXPECT().
+					ListServiceLaborReport(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return([]db.ListServiceLaborReportRow{row}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got []ServiceLaborReportResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &got))
+				require.Equal(t, []ServiceLaborReportResponse{{
+					ServiceID:      row.ServiceID,
+					Name:           row.Name,
+					Lines:          4,
+					EstimatedHours: 3,
+					ActualHours:    4.17,
+					OverrunHours:   1.17,
+				}}, got)
+			},
+		},
+		{
+			name:  "ToBeforeFrom",
+			query: "from=2022-06-30&to=2022-06-01",
+			role:  util.RoleAdmin,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListServiceLaborReport(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInvalidRequest)
+			},
+		},
+		{
+			name:  "InvalidDate",
+			query: "from=June&to=2022-06-01",
+			role:  util.RoleAdmin,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListServiceLaborReport(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInvalidRequest)
+			},
+		},
+		{
+			name:  "MechanicForbidden",
+			query: "from=2022-06-01&to=2022-06-30",
+			role:  util.RoleMechanic,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListServiceLaborReport(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeForbidden)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/reports/labor?"+tc.query, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, util.RandomName(), tc.role, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
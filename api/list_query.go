@@ -0,0 +1,220 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	db "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/sqlc"
+)
+
+// sortParam is the query parameter naming the field a list is sorted by, descending when
+// prefixed with a "-", e.g. ?sort=-created_at
+const sortParam = "sort"
+
+// reservedParams are the query parameters of a list endpoint that are not filters
+var reservedParams = map[string]bool{"cursor": true, "page_size": true, sortParam: true}
+
+// sortField is a field a list can be sorted by. value returns the field of a row as it is
+// kept in the cursor of the next page.
+type sortField[T any] struct {
+	column string
+	value  func(T) string
+}
+
+// filterField is a query parameter filtering a list, parse turns its value into the
+// value compared to the column
+type filterField struct {
+	column string
+	op     string
+	parse  func(string) (interface{}, error)
+}
+
+// listSpec whitelists the fields a list endpoint can be sorted and filtered by, the
+// columns the queries are built from never come from the request
+type listSpec[T any] struct {
+	sorts   map[string]sortField[T]
+	filters map[string]filterField
+	id      func(T) int64
+}
+
+// listQuery is a list request checked against a listSpec
+type listQuery struct {
+	params db.ListParams
+	// sort is the sort parameter of the request, the cursor of the next page carries it
+	// so a page is not continued with another order
+	sort string
+	size int32
+}
+
+// parse checks the sort, the filters and the cursor of a list request, and returns the
+// query of the page. Unknown fields are rejected rather than ignored.
+func (spec listSpec[T]) parse(values url.Values, page pageRequest) (listQuery, error) {
+	query := listQuery{size: page.size()}
+	query.params.Limit = query.size + 1
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	// the conditions, and so the query arguments, are in the same order for the same request
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if reservedParams[key] {
+			continue
+		}
+		filter, ok := spec.filters[key]
+		if !ok {
+			return query, fmt.Errorf("unknown filter %q", key)
+		}
+		if len(values[key]) != 1 {
+			return query, fmt.Errorf("filter %q must be given once", key)
+		}
+		value, err := filter.parse(values.Get(key))
+		if err != nil {
+			return query, fmt.Errorf("invalid %s: %w", key, err)
+		}
+		query.params.Where = append(query.params.Where, db.Condition{Column: filter.column, Op: filter.op, Value: value})
+	}
+
+	query.sort = values.Get(sortParam)
+	name := strings.TrimPrefix(query.sort, "-")
+	query.params.Desc = name != query.sort
+	if name != "" && name != "id" {
+		field, ok := spec.sorts[name]
+		if !ok {
+			return query, fmt.Errorf("unknown sort field %q", name)
+		}
+		query.params.SortColumn = field.column
+	}
+
+	if page.Cursor != "" {
+		cursor, err := decodeCursor(page.Cursor)
+		if err != nil {
+			return query, err
+		}
+		if cursor.Sort != query.sort {
+			return query, errInvalidCursor
+		}
+		query.params.After = &db.Keyset{Value: cursor.AfterValue, ID: cursor.AfterID}
+	}
+	return query, nil
+}
+
+// nextPage trims the rows of a listQuery to the page and returns the cursor to the next one
+func (spec listSpec[T]) nextPage(rows []T, query listQuery) ([]T, string) {
+	field := spec.sorts[strings.TrimPrefix(query.sort, "-")]
+	return nextPage(rows, query.size, func(row T) pageCursor {
+		cursor := pageCursor{Sort: query.sort, AfterID: spec.id(row)}
+		if query.params.SortColumn != "" {
+			cursor.AfterValue = field.value(row)
+		}
+		return cursor
+	})
+}
+
+func parseIntFilter(s string) (interface{}, error) {
+	n, err := strconv.ParseInt(s, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("%q is not an integer", s)
+	}
+	return n, nil
+}
+
+// parseTimeFilter reads a date, as midnight UTC, or an RFC 3339 time
+func parseTimeFilter(s string) (interface{}, error) {
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, fmt.Errorf("%q is neither a date nor an RFC 3339 time", s)
+	}
+	return t.UTC(), nil
+}
+
+// parseDecimalFilter checks a decimal number, it is sent as text like the NUMERIC columns
+func parseDecimalFilter(s string) (interface{}, error) {
+	if _, err := strconv.ParseFloat(s, 64); err != nil {
+		return nil, fmt.Errorf("%q is not a number", s)
+	}
+	return s, nil
+}
+
+func parseTextFilter(s string) (interface{}, error) {
+	if s == "" {
+		return nil, fmt.Errorf("empty value")
+	}
+	return s, nil
+}
+
+func parseServiceOrderStateFilter(s string) (interface{}, error) {
+	state, ok := db.ParseServiceOrderState(s)
+	if !ok {
+		return nil, fmt.Errorf("unknown state %q", s)
+	}
+	return state, nil
+}
+
+var customerListSpec = listSpec[db.Customer]{
+	sorts: map[string]sortField[db.Customer]{
+		"full_name":  {column: "full_name", value: func(c db.Customer) string { return c.FullName }},
+		"created_at": {column: "created_at", value: func(c db.Customer) string { return c.CreatedAt.Format(time.RFC3339Nano) }},
+	},
+	filters: map[string]filterField{
+		"name_prefix":    {column: "full_name", op: db.OpPrefix, parse: parseTextFilter},
+		"created_after":  {column: "created_at", op: db.OpGte, parse: parseTimeFilter},
+		"created_before": {column: "created_at", op: db.OpLt, parse: parseTimeFilter},
+	},
+	id: func(c db.Customer) int64 { return c.ID },
+}
+
+var carListSpec = listSpec[db.Car]{
+	sorts: map[string]sortField[db.Car]{
+		"customer_id": {column: "customer_id", value: func(c db.Car) string { return strconv.Itoa(int(c.CustomerID)) }},
+		"make":        {column: "make", value: func(c db.Car) string { return c.Make }},
+		"model":       {column: "model", value: func(c db.Car) string { return c.Model }},
+		"year":        {column: "year", value: func(c db.Car) string { return c.Year }},
+	},
+	filters: map[string]filterField{
+		"customer_id":         {column: "customer_id", op: db.OpEq, parse: parseIntFilter},
+		"make_prefix":         {column: "make", op: db.OpPrefix, parse: parseTextFilter},
+		"model_prefix":        {column: "model", op: db.OpPrefix, parse: parseTextFilter},
+		"registration_prefix": {column: "registraion_number", op: db.OpPrefix, parse: parseTextFilter},
+		"energy":              {column: "energy", op: db.OpEq, parse: parseTextFilter},
+		"year":                {column: "year", op: db.OpEq, parse: parseTextFilter},
+	},
+	id: func(c db.Car) int64 { return int64(c.ID) },
+}
+
+var serviceOrderListSpec = listSpec[db.ServiceOrder]{
+	sorts: map[string]sortField[db.ServiceOrder]{
+		"car_id": {column: "car_id", value: func(o db.ServiceOrder) string { return strconv.Itoa(int(o.CarID)) }},
+		"state":  {column: "state", value: func(o db.ServiceOrder) string { return strconv.Itoa(int(o.State)) }},
+	},
+	filters: map[string]filterField{
+		"car_id":          {column: "car_id", op: db.OpEq, parse: parseIntFilter},
+		"state":           {column: "state", op: db.OpEq, parse: parseServiceOrderStateFilter},
+		"received_after":  {column: "date_received", op: db.OpGte, parse: parseTimeFilter},
+		"received_before": {column: "date_received", op: db.OpLt, parse: parseTimeFilter},
+	},
+	id: func(o db.ServiceOrder) int64 { return int64(o.ID) },
+}
+
+var partListSpec = listSpec[db.Part]{
+	sorts: map[string]sortField[db.Part]{
+		"name":          {column: "name", value: func(p db.Part) string { return p.Name }},
+		"retail_price":  {column: "retail_price", value: func(p db.Part) string { return p.RetailPrice }},
+		"reorder_level": {column: "reorder_level", value: func(p db.Part) string { return strconv.Itoa(int(p.ReorderLevel)) }},
+	},
+	filters: map[string]filterField{
+		"name_prefix": {column: "name", op: db.OpPrefix, parse: parseTextFilter},
+		"price_min":   {column: "retail_price", op: db.OpGte, parse: parseDecimalFilter},
+		"price_max":   {column: "retail_price", op: db.OpLte, parse: parseDecimalFilter},
+	},
+	id: func(p db.Part) int64 { return int64(p.ID) },
+}
@@ -0,0 +1,86 @@
+package api
+
+import (
+	"net/url"
+	"testing"
+
+	db "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListSpecParse(t *testing.T) {
+	values := url.Values{
+		"sort":      {"-retail_price"},
+		"price_min": {"10.5"},
+		"price_max": {"99"},
+		"page_size": {"5"},
+	}
+	query, err := partListSpec.parse(values, pageRequest{PageSize: 5})
+	require.NoError(t, err)
+	require.Equal(t, db.ListParams{
+		Where: []db.Condition{
+			{Column: "retail_price", Op: db.OpLte, Value: "99"},
+			{Column: "retail_price", Op: db.OpGte, Value: "10.5"},
+		},
+		SortColumn: "retail_price",
+		Desc:       true,
+		Limit:      6,
+	}, query.params)
+
+	query, err = serviceOrderListSpec.parse(url.Values{"state": {"ready"}, "car_id": {"7"}}, pageRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []db.Condition{
+		{Column: "car_id", Op: db.OpEq, Value: int64(7)},
+		{Column: "state", Op: db.OpEq, Value: db.ServiceOrderReady},
+	}, query.params.Where)
+	require.Empty(t, query.params.SortColumn)
+	require.False(t, query.params.Desc)
+
+	query, err = carListSpec.parse(url.Values{"sort": {"-id"}}, pageRequest{})
+	require.NoError(t, err)
+	require.Empty(t, query.params.SortColumn)
+	require.True(t, query.params.Desc)
+
+	for _, invalid := range []url.Values{
+		{"unknown": {"1"}},
+		{"sort": {"description"}},
+		{"sort": {"--name"}},
+		{"price_min": {"cheap"}},
+		{"price_min": {"1", "2"}},
+		{"name_prefix": {""}},
+	} {
+		_, err = partListSpec.parse(invalid, pageRequest{})
+		require.Error(t, err, invalid)
+	}
+	_, err = serviceOrderListSpec.parse(url.Values{"state": {"lost"}}, pageRequest{})
+	require.Error(t, err)
+	_, err = customerListSpec.parse(url.Values{"created_after": {"yesterday"}}, pageRequest{})
+	require.Error(t, err)
+}
+
+func TestListSpecCursor(t *testing.T) {
+	parts := []db.Part{
+		{ID: 3, Name: "filter", RetailPrice: "12.00"},
+		{ID: 1, Name: "pads", RetailPrice: "12.00"},
+		{ID: 2, Name: "belt", RetailPrice: "8.50"},
+	}
+	values := url.Values{"sort": {"-retail_price"}}
+
+	query, err := partListSpec.parse(values, pageRequest{PageSize: 2})
+	require.NoError(t, err)
+	rows, next := partListSpec.nextPage(parts, query)
+	require.Equal(t, parts[:2], rows)
+	require.Equal(t, encodeCursor(pageCursor{Sort: "-retail_price", AfterValue: "12.00", AfterID: 1}), next)
+
+	query, err = partListSpec.parse(values, pageRequest{Cursor: next, PageSize: 2})
+	require.NoError(t, err)
+	require.Equal(t, &db.Keyset{Value: "12.00", ID: 1}, query.params.After)
+
+	rows, next = partListSpec.nextPage(parts[2:], query)
+	require.Equal(t, parts[2:], rows)
+	require.Empty(t, next)
+
+	// a cursor only continues the order it was made for
+	_, err = partListSpec.parse(url.Values{"sort": {"name"}}, pageRequest{Cursor: encodeCursor(pageCursor{Sort: "-retail_price", AfterID: 1})})
+	require.EqualError(t, err, errInvalidCursor.Error())
+}
@@ -0,0 +1,392 @@
+package api
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+
+	db "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/sqlc"
+	"github.com/gin-gonic/gin"
+)
+
+// swagger:model createMechanicRequest
+type createMechanicRequest struct {
+	// The Name of a Mechanic
+	// example: Karim Stam
+	FullName string `json:"fullName" binding:"required"`
+}
+
+// createMechanic godoc
+// @Summary Create new Mechanic
+// @Description Create a new Mechanic
+// @ID create-Mechanic
+// @Tags Mechanic
+// @Accept  json
+// @Produce  json
+// @Param Body body createMechanicRequest true "The body to create a Mechanic"
+// @Success 200 {object} db.Mechanic
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /mechanics [post]
+func (server *Server) createMechanic(ctx *gin.Context) {
+	var req createMechanicRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	mechanic, err := server.store.CreateMechanic(ctx, req.FullName)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, mechanic)
+}
+
+type getMechanicRequest struct {
+	ID int32 `uri:"id" binding:"required,min=1"`
+}
+
+// getMechanic godoc
+// @Summary  GET Mechanic
+// @Description  GET  Mechanic by it's id
+// @Tags Mechanic
+// @ID get-Mechanic
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id to get a Mechanic"
+// @Success 200 {object} db.Mechanic
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /mechanics/{id} [get]
+func (server *Server) getMechanic(ctx *gin.Context) {
+	var req getMechanicRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	mechanic, err := server.store.GetMechanic(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, mechanic)
+}
+
+// swagger:model ListMechanicsRequest
+type ListMechanicsRequest struct {
+	pageRequest
+}
+
+// listMechanics godoc
+// @Summary list all Mechanics
+// @Description GET list of all Mechanics
+// @Tags Mechanic
+// @ID list-Mechanic
+// @Accept  json
+// @Produce  json
+// @Param cursor query string false "The next_cursor of the previous page, none for the first page"
+// @Param page_size query int false "The number of Mechanics per page, 20 by default"
+// @Success 200 {object} PageResponse{items=[]db.Mechanic}
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /mechanics [get]
+func (server *Server) listMechanics(ctx *gin.Context) {
+	var req ListMechanicsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+	cursor, err := decodeCursor(req.Cursor)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	arg := db.ListMechanicsParams{
+		AfterID: int32(cursor.AfterID),
+		Limit:   req.size() + 1,
+	}
+	mechanics, err := server.store.ListMechanics(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+	totalCount, err := server.store.CountMechanics(ctx)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+	mechanics, nextCursor := nextPage(mechanics, req.size(), func(mechanic db.Mechanic) pageCursor {
+		return pageCursor{AfterID: int64(mechanic.ID)}
+	})
+
+	ctx.JSON(http.StatusOK, PageResponse{Items: mechanics, NextCursor: nextCursor, TotalCount: totalCount})
+}
+
+// swagger:model updateMechanicRequest
+type updateMechanicRequest struct {
+	// The Name of a Mechanic
+	// example: Karim Stam
+	FullName string `json:"fullName" binding:"required"`
+}
+
+// updateMechanic godoc
+// @Summary update  Mechanic
+// @Description update a  Mechanic
+// @Tags Mechanic
+// @ID update-Mechanic
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id to update a Mechanic"
+// @Param Body body updateMechanicRequest true "The body to update a Mechanic"
+// @Success 200 {object} db.Mechanic
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /mechanics/{id} [put]
+func (server *Server) updateMechanic(ctx *gin.Context) {
+	var uri getMechanicRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	var req updateMechanicRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	arg := db.UpdateMechanicParams{
+		ID:       uri.ID,
+		FullName: req.FullName,
+	}
+	mechanic, err := server.store.UpdateMechanic(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, mechanic)
+}
+
+// deleteMechanic godoc
+// @Summary DELETE a Mechanic
+// @Description use this api to delete a mechanic by it's id
+// @Tags Mechanic
+// @ID delete-Mechanic
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id to delete a Mechanic"
+// @Success 204 string deleted
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /mechanics/{id} [delete]
+func (server *Server) deleteMechanic(ctx *gin.Context) {
+	var req getMechanicRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	rows, err := server.store.DeleteMechanic(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+	if rows == 0 {
+		ctx.JSON(http.StatusNotFound, errorResponse(codeNotFound, sql.ErrNoRows))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, "deleted")
+}
+
+// getMechanicWorkload godoc
+// @Summary list the open Service Orders of a Mechanic
+// @Description GET the Service Orders a Mechanic is assigned to that are neither returned nor cancelled, oldest first
+// @Tags Mechanic
+// @ID workload-Mechanic
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id of the Mechanic"
+// @Success 200 {array} ServiceOrderResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /mechanics/{id}/workload [get]
+func (server *Server) getMechanicWorkload(ctx *gin.Context) {
+	var req getMechanicRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	mechanic, err := server.store.GetMechanic(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	arg := db.ListMechanicWorkloadParams{
+		MechanicID:   mechanic.ID,
+		ClosedStates: db.ClosedServiceOrderStates,
+	}
+	orders, err := server.store.ListMechanicWorkload(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	rsp := make([]ServiceOrderResponse, 0, len(orders))
+	for _, order := range orders {
+		rsp = append(rsp, newServiceOrderResponse(order))
+	}
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+// swagger:model assignMechanicRequest
+type assignMechanicRequest struct {
+	// The ID of the Mechanic to assign
+	// example: 1
+	MechanicID int32 `json:"mechanicId" binding:"required,min=1"`
+}
+
+// assignMechanic godoc
+// @Summary assign a Mechanic to a Service Order
+// @Description assign a Mechanic to a Service Order that is neither returned nor cancelled
+// @Tags Mechanic
+// @ID assign-Mechanic
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id of the Service Order"
+// @Param Body body assignMechanicRequest true "The Mechanic to assign"
+// @Success 200 {object} db.MechanicDetail
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /service-orders/{id}/mechanics [post]
+func (server *Server) assignMechanic(ctx *gin.Context) {
+	var uri getServiceOrderRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	var req assignMechanicRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	order, err := server.store.GetServiceOrder(ctx, uri.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	if db.IsServiceOrderClosed(order.State) {
+		err := fmt.Errorf("service order %d is %s", order.ID, db.ServiceOrderStateName(order.State))
+		ctx.JSON(http.StatusConflict, errorResponse(codeConflict, err))
+		return
+	}
+
+	arg := db.AssignMechanicParams{
+		MechanicID:     req.MechanicID,
+		ServiceOrderID: order.ID,
+	}
+	detail, err := server.store.AssignMechanic(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, detail)
+}
+
+// listServiceOrderMechanics godoc
+// @Summary list the Mechanics of a Service Order
+// @Description GET the Mechanics assigned to a Service Order
+// @Tags Mechanic
+// @ID list-ServiceOrder-Mechanic
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id of the Service Order"
+// @Success 200 {array} db.Mechanic
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /service-orders/{id}/mechanics [get]
+func (server *Server) listServiceOrderMechanics(ctx *gin.Context) {
+	var req getServiceOrderRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	mechanics, err := server.store.ListServiceOrderMechanics(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, mechanics)
+}
+
+type unassignMechanicRequest struct {
+	ServiceOrderID int32 `uri:"id" binding:"required,min=1"`
+	MechanicID     int32 `uri:"mechanic_id" binding:"required,min=1"`
+}
+
+// unassignMechanic godoc
+// @Summary unassign a Mechanic from a Service Order
+// @Description remove a Mechanic from a Service Order
+// @Tags Mechanic
+// @ID unassign-Mechanic
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id of the Service Order"
+// @Param mechanic_id path string true  "The id of the Mechanic"
+// @Success 204 string deleted
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /service-orders/{id}/mechanics/{mechanic_id} [delete]
+func (server *Server) unassignMechanic(ctx *gin.Context) {
+	var req unassignMechanicRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	arg := db.UnassignMechanicParams{
+		ServiceOrderID: req.ServiceOrderID,
+		MechanicID:     req.MechanicID,
+	}
+	rows, err := server.store.UnassignMechanic(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+	if rows == 0 {
+		ctx.JSON(http.StatusNotFound, errorResponse(codeNotFound, sql.ErrNoRows))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, "deleted")
+}
@@ -0,0 +1,40 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+var errMergePatchNotObject = errors.New("a merge patch must be a JSON object")
+
+// bindMergePatch binds a JSON merge patch (RFC 7396) to req, whose fields are pointers left
+// nil for the members the patch omits. The patched columns are NOT NULL, so a null member,
+// which removes a field in a merge patch, is rejected rather than read as an omitted one.
+func bindMergePatch(ctx *gin.Context, req interface{}) error {
+	data, err := ctx.GetRawData()
+	if err != nil {
+		return err
+	}
+
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(data, &members); err != nil || members == nil {
+		return errMergePatchNotObject
+	}
+	names := make([]string, 0, len(members))
+	for name := range members {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if string(members[name]) == "null" {
+			return fmt.Errorf("%s cannot be removed", name)
+		}
+	}
+
+	return binding.JSON.BindBody(data, req)
+}
@@ -0,0 +1,84 @@
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// defaultPageSize is the number of items of a page when page_size is not given
+const defaultPageSize = 20
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+// pageRequest is embedded in the requests of the list endpoints: the first page has no
+// cursor, the next ones start after the cursor returned with the previous page
+type pageRequest struct {
+	Cursor   string `form:"cursor"`
+	PageSize int32  `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
+
+func (req pageRequest) size() int32 {
+	if req.PageSize == 0 {
+		return defaultPageSize
+	}
+	return req.PageSize
+}
+
+// pageCursor is the key of the last item of a page, it is handed to clients as an opaque
+// string so what it holds can change without breaking them
+type pageCursor struct {
+	AfterID  int64  `json:"after_id,omitempty"`
+	AfterKey string `json:"after_key,omitempty"`
+	// The sort of the page and the value of its sort field on the last item, for the lists
+	// that can be sorted
+	Sort       string `json:"sort,omitempty"`
+	AfterValue string `json:"after_value,omitempty"`
+}
+
+func encodeCursor(cursor pageCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCursor reads the cursor of a pageRequest, no cursor is the start of the first page
+func decodeCursor(s string) (pageCursor, error) {
+	var cursor pageCursor
+	if s == "" {
+		return cursor, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return cursor, errInvalidCursor
+	}
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.AfterID < 0 {
+		return cursor, errInvalidCursor
+	}
+	return cursor, nil
+}
+
+// swagger:model PageResponse
+type PageResponse struct {
+	// The items of the page
+	Items interface{} `json:"items"`
+	// The cursor to send to get the next page, empty on the last page
+	// example: eyJhZnRlcl9pZCI6MjB9
+	NextCursor string `json:"next_cursor"`
+	// The number of items of all the pages
+	// example: 42
+	TotalCount int64 `json:"total_count"`
+}
+
+// nextPage trims the extra row the list queries fetch to know if another page follows,
+// and returns the cursor to that page made from the last row kept
+func nextPage[T any](rows []T, size int32, cursorOf func(T) pageCursor) ([]T, string) {
+	if rows == nil {
+		rows = []T{}
+	}
+	if len(rows) <= int(size) {
+		return rows, ""
+	}
+	rows = rows[:size]
+	return rows, encodeCursor(cursorOf(rows[len(rows)-1]))
+}
@@ -0,0 +1,45 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageCursor(t *testing.T) {
+	cursor := pageCursor{AfterID: 42}
+	got, err := decodeCursor(encodeCursor(cursor))
+	require.NoError(t, err)
+	require.Equal(t, cursor, got)
+
+	cursor = pageCursor{AfterKey: "karim"}
+	got, err = decodeCursor(encodeCursor(cursor))
+	require.NoError(t, err)
+	require.Equal(t, cursor, got)
+
+	got, err = decodeCursor("")
+	require.NoError(t, err)
+	require.Zero(t, got)
+
+	for _, invalid := range []string{"%%%", "bm90IGpzb24", encodeCursor(pageCursor{AfterID: -1})} {
+		_, err = decodeCursor(invalid)
+		require.EqualError(t, err, errInvalidCursor.Error())
+	}
+}
+
+func TestNextPage(t *testing.T) {
+	cursorOf := func(id int) pageCursor { return pageCursor{AfterID: int64(id)} }
+
+	rows, next := nextPage([]int{1, 2, 3}, 2, cursorOf)
+	require.Equal(t, []int{1, 2}, rows)
+	require.Equal(t, encodeCursor(pageCursor{AfterID: 2}), next)
+
+	rows, next = nextPage([]int{1, 2}, 2, cursorOf)
+	require.Equal(t, []int{1, 2}, rows)
+	require.Empty(t, next)
+
+	rows, next = nextPage(nil, 2, cursorOf)
+	require.NotNil(t, rows)
+	require.Empty(t, rows)
+	require.Empty(t, next)
+}
@@ -0,0 +1,289 @@
+package api
+
+import (
+	"database/sql"
+	"net/http"
+
+	db "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/sqlc"
+	"github.com/gin-gonic/gin"
+)
+
+// swagger:model createPartRequest
+type createPartRequest struct {
+	// The Name of a Part
+	// example: oil filter
+	Name string `json:"name" binding:"required"`
+	// The Description of a Part
+	// example: oil filter for Renault Clio 4
+	Description string `json:"description" binding:"required"`
+	// The price the Part is sold at
+	// example: 1200.00
+	RetailPrice string `json:"retailPrice" binding:"required,numeric"`
+	// The stock on hand at or below which the Part is reported as low on stock
+	// example: 5
+	ReorderLevel int32 `json:"reorderLevel" binding:"min=0"`
+}
+
+// createPart godoc
+// @Summary Create new Part
+// @Description Create a new Part
+// @ID create-Part
+// @Tags Part
+// @Accept  json
+// @Produce  json
+// @Param Body body createPartRequest true "The body to create a Part"
+// @Success 200 {object} db.Part
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /parts [post]
+func (server *Server) createPart(ctx *gin.Context) {
+	var req createPartRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	arg := db.CreatePartParams{
+		Name:         req.Name,
+		Description:  req.Description,
+		RetailPrice:  req.RetailPrice,
+		ReorderLevel: req.ReorderLevel,
+	}
+	part, err := server.store.CreatePart(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, part)
+}
+
+type getPartRequest struct {
+	ID int32 `uri:"id" binding:"required,min=1"`
+}
+
+// getPart godoc
+// @Summary  GET Part
+// @Description  GET  Part by it's id
+// @Tags Part
+// @ID get-Part
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id to get a Part"
+// @Success 200 {object} db.Part
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /parts/{id} [get]
+func (server *Server) getPart(ctx *gin.Context) {
+	var req getPartRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	part, err := server.store.GetPart(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, part)
+}
+
+// swagger:model ListPartsRequest
+type ListPartsRequest struct {
+	pageRequest
+}
+
+// listParts godoc
+// @Summary list all Parts
+// @Description GET list of all Parts
+// @Tags Part
+// @ID list-Part
+// @Accept  json
+// @Produce  json
+// @Param cursor query string false "The next_cursor of the previous page, none for the first page"
+// @Param page_size query int false "The number of Parts per page, 20 by default"
+// @Param sort query string false "The field to sort by, descending when prefixed with a -: name, retail_price, reorder_level or id (default)"
+// @Param name_prefix query string false "Only the Parts whose name starts with it, ignoring case"
+// @Param price_min query number false "Only the Parts whose retail price is at least this"
+// @Param price_max query number false "Only the Parts whose retail price is at most this"
+// @Success 200 {object} PageResponse{items=[]db.Part}
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /parts [get]
+func (server *Server) listParts(ctx *gin.Context) {
+	var req ListPartsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+	query, err := partListSpec.parse(ctx.Request.URL.Query(), req.pageRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	parts, err := server.store.ListPartsWhere(ctx, query.params)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+	totalCount, err := server.store.CountPartsWhere(ctx, query.params.Where)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+	parts, nextCursor := partListSpec.nextPage(parts, query)
+
+	ctx.JSON(http.StatusOK, PageResponse{Items: parts, NextCursor: nextCursor, TotalCount: totalCount})
+}
+
+// swagger:model updatePartRequest
+type updatePartRequest struct {
+	// The Name of a Part
+	// example: oil filter
+	Name string `json:"name" binding:"required"`
+	// The Description of a Part
+	// example: oil filter for Renault Clio 4
+	Description string `json:"description" binding:"required"`
+	// The price the Part is sold at
+	// example: 1200.00
+	RetailPrice string `json:"retailPrice" binding:"required,numeric"`
+	// The stock on hand at or below which the Part is reported as low on stock
+	// example: 5
+	ReorderLevel int32 `json:"reorderLevel" binding:"min=0"`
+}
+
+// updatePart godoc
+// @Summary update  Part
+// @Description update a  Part
+// @Tags Part
+// @ID update-Part
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id to update a Part"
+// @Param Body body updatePartRequest true "The body to update a Part"
+// @Success 200 {object} db.Part
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /parts/{id} [put]
+func (server *Server) updatePart(ctx *gin.Context) {
+	var uri getPartRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	var req updatePartRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	arg := db.UpdatePartParams{
+		ID:           uri.ID,
+		Name:         req.Name,
+		Description:  req.Description,
+		RetailPrice:  req.RetailPrice,
+		ReorderLevel: req.ReorderLevel,
+	}
+	part, err := server.store.UpdatePart(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, part)
+}
+
+// deletePart godoc
+// @Summary DELETE a Part
+// @Description use this api to delete a part by it's id
+// @Tags Part
+// @ID delete-Part
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id to delete a Part"
+// @Success 204 string deleted
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /parts/{id} [delete]
+func (server *Server) deletePart(ctx *gin.Context) {
+	var req getPartRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	rows, err := server.store.DeletePart(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+	if rows == 0 {
+		ctx.JSON(http.StatusNotFound, errorResponse(codeNotFound, sql.ErrNoRows))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, "deleted")
+}
+
+// getPartStock godoc
+// @Summary  GET the stock of a Part
+// @Description  GET the quantity purchased, used on service orders and on hand of a Part
+// @Tags Part
+// @ID stock-Part
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id of the Part"
+// @Success 200 {object} db.PartStock
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /parts/{id}/stock [get]
+func (server *Server) getPartStock(ctx *gin.Context) {
+	var req getPartRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	stock, err := server.store.GetPartStock(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, stock)
+}
+
+// listLowStockParts godoc
+// @Summary list the Parts low on stock
+// @Description GET the Parts whose stock on hand is at or below their reorder level, most needed first
+// @Tags Part
+// @ID low-stock-Part
+// @Accept  json
+// @Produce  json
+// @Success 200 {array} db.PartStock
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /parts/low-stock [get]
+func (server *Server) listLowStockParts(ctx *gin.Context) {
+	stocks, err := server.store.ListLowStockParts(ctx)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, stocks)
+}
@@ -0,0 +1,167 @@
+package api
+
+import (
+	"net/http"
+
+	db "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/sqlc"
+	"github.com/gin-gonic/gin"
+)
+
+// swagger:model PartDetailResponse
+type PartDetailResponse struct {
+	// The ID of the line
+	// example: 1
+	ID int32 `json:"id"`
+	// The ID of the Part used
+	// example: 1
+	PartID int32 `json:"part_id"`
+	// The ID of the Service Order
+	// example: 1
+	ServiceOrderID int32 `json:"service_order_id"`
+	// The quantity used
+	// example: 2
+	Quantity int32 `json:"quantity"`
+	// The unit price charged
+	// example: 1200.00
+	Price string `json:"price"`
+}
+
+func newPartDetailResponse(detail db.PartDetail) PartDetailResponse {
+	return PartDetailResponse{
+		ID:             detail.ID,
+		PartID:         detail.PartID,
+		ServiceOrderID: detail.ServiceOrderID,
+		Quantity:       detail.Quantity,
+		Price:          detail.Price.String,
+	}
+}
+
+// swagger:model addPartDetailRequest
+type addPartDetailRequest struct {
+	// The ID of the Part used
+	// example: 1
+	PartID int32 `json:"partId" binding:"required,min=1"`
+	// The quantity used
+	// example: 2
+	Quantity int32 `json:"quantity" binding:"required,min=1"`
+	// The unit price charged, defaults to the retail price of the Part
+	// example: 1200.00
+	Price string `json:"price" binding:"omitempty,numeric"`
+}
+
+// addPartDetail godoc
+// @Summary use a Part on a Service Order
+// @Description add a Part line to a Service Order, refused when there is not enough of the Part on hand
+// @Tags Part
+// @ID add-PartDetail
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id of the Service Order"
+// @Param Body body addPartDetailRequest true "The Part used"
+// @Success 200 {object} PartDetailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /service-orders/{id}/parts [post]
+func (server *Server) addPartDetail(ctx *gin.Context) {
+	var uri getServiceOrderRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	var req addPartDetailRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	arg := db.AddPartDetailTxParams{
+		ServiceOrderID: uri.ID,
+		PartID:         req.PartID,
+		Quantity:       req.Quantity,
+		Price:          req.Price,
+	}
+	detail, err := server.store.AddPartDetailTx(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newPartDetailResponse(detail))
+}
+
+// listServiceOrderParts godoc
+// @Summary list the Parts used on a Service Order
+// @Description GET the Part lines of a Service Order
+// @Tags Part
+// @ID list-PartDetail
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id of the Service Order"
+// @Success 200 {array} PartDetailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /service-orders/{id}/parts [get]
+func (server *Server) listServiceOrderParts(ctx *gin.Context) {
+	var req getServiceOrderRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	details, err := server.store.ListServiceOrderParts(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	rsp := make([]PartDetailResponse, 0, len(details))
+	for _, detail := range details {
+		rsp = append(rsp, newPartDetailResponse(detail))
+	}
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+type deletePartDetailRequest struct {
+	ServiceOrderID int32 `uri:"id" binding:"required,min=1"`
+	PartID         int32 `uri:"part_id" binding:"required,min=1"`
+}
+
+// deletePartDetail godoc
+// @Summary remove a Part from a Service Order
+// @Description remove a Part line from an open Service Order that was not invoiced, the Part goes back to stock
+// @Tags Part
+// @ID delete-PartDetail
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id of the Service Order"
+// @Param part_id path string true  "The id of the Part"
+// @Success 204 string deleted
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /service-orders/{id}/parts/{part_id} [delete]
+func (server *Server) deletePartDetail(ctx *gin.Context) {
+	var req deletePartDetailRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	arg := db.DeletePartDetailParams{
+		ServiceOrderID: req.ServiceOrderID,
+		PartID:         req.PartID,
+	}
+	if err := server.store.DeletePartDetailTx(ctx, arg); err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, "deleted")
+}
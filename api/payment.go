@@ -0,0 +1,292 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	db "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/sqlc"
+	"github.com/gin-gonic/gin"
+)
+
+// agingBuckets are the buckets of the sale_invoice_balances view, youngest first
+var agingBuckets = []string{"0-30", "31-60", "60+"}
+
+// swagger:model SaleInvoiceBalanceResponse
+type SaleInvoiceBalanceResponse struct {
+	// The ID of the Sale Invoice
+	// example: 1
+	SaleInvoiceID int32 `json:"sale_invoice_id"`
+	// The ID of the Service Order invoiced
+	// example: 1
+	ServiceOrderID int32 `json:"service_order_id"`
+	// The sequential reference of the invoice
+	// example: INV-000042
+	Ref string `json:"ref"`
+	// The day the invoice was issued
+	// example: 2022-06-03T00:00:00Z
+	Date *time.Time `json:"date"`
+	// The total of the invoice
+	// example: 14500.00
+	Total string `json:"total"`
+	// The sum of the payments of the invoice
+	// example: 5000.00
+	Paid string `json:"paid"`
+	// The amount left to pay, negative when the invoice is overpaid
+	// example: 9500.00
+	Outstanding string `json:"outstanding"`
+	// Whether the invoice is unpaid, partial, paid or overpaid
+	// example: partial
+	Status string `json:"status"`
+	// The number of days since the invoice was issued
+	// example: 12
+	AgeDays int32 `json:"age_days"`
+	// The aging bucket of the invoice: 0-30, 31-60 or 60+
+	// example: 0-30
+	AgingBucket string `json:"aging_bucket"`
+}
+
+func newSaleInvoiceBalanceResponse(balance db.SaleInvoiceBalance) SaleInvoiceBalanceResponse {
+	return SaleInvoiceBalanceResponse{
+		SaleInvoiceID:  balance.SaleInvoiceID,
+		ServiceOrderID: balance.ServiceOrderID,
+		Ref:            balance.Ref,
+		Date:           nullTime(balance.Date),
+		Total:          balance.Total,
+		Paid:           balance.Paid,
+		Outstanding:    balance.Outstanding,
+		Status:         balance.Status,
+		AgeDays:        balance.AgeDays,
+		AgingBucket:    balance.AgingBucket,
+	}
+}
+
+// swagger:model createPaymentRequest
+type createPaymentRequest struct {
+	// The amount paid
+	// example: 5000.00
+	Amount string `json:"amount" binding:"required,numeric"`
+	// The day of the payment, defaults to today
+	// example: 2022-06-03
+	Date string `json:"date" binding:"omitempty,datetime=2006-01-02"`
+}
+
+// createPayment godoc
+// @Summary record a Payment
+// @Description record a full or partial Payment against a Sale Invoice, paying more than the outstanding balance
+// @Description is accepted and shows the invoice as overpaid
+// @Tags Payment
+// @ID create-Payment
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id of the Sale Invoice"
+// @Param Body body createPaymentRequest true "The Payment"
+// @Success 200 {object} db.Payment
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /invoices/{id}/payments [post]
+func (server *Server) createPayment(ctx *gin.Context) {
+	var uri getSaleInvoiceRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	var req createPaymentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	if amount, _ := strconv.ParseFloat(req.Amount, 64); amount <= 0 {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, errors.New("amount must be positive")))
+		return
+	}
+
+	invoice, err := server.store.GetSaleInvoice(ctx, uri.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	date := time.Now()
+	if req.Date != "" {
+		date = nullDate(req.Date).Time
+	}
+
+	arg := db.CreatePaymentParams{
+		SaleInvoiceID: invoice.ID,
+		Amount:        req.Amount,
+		Date:          date,
+	}
+	payment, err := server.store.CreatePayment(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, payment)
+}
+
+// listInvoicePayments godoc
+// @Summary list the Payments of a Sale Invoice
+// @Description GET the Payments recorded against a Sale Invoice, oldest first
+// @Tags Payment
+// @ID list-Payment
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id of the Sale Invoice"
+// @Success 200 {array} db.Payment
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /invoices/{id}/payments [get]
+func (server *Server) listInvoicePayments(ctx *gin.Context) {
+	var req getSaleInvoiceRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	payments, err := server.store.ListInvoicePayments(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, payments)
+}
+
+type deletePaymentRequest struct {
+	SaleInvoiceID int32 `uri:"id" binding:"required,min=1"`
+	ID            int32 `uri:"payment_id" binding:"required,min=1"`
+}
+
+// deletePayment godoc
+// @Summary DELETE a Payment
+// @Description remove a Payment recorded by mistake from a Sale Invoice
+// @Tags Payment
+// @ID delete-Payment
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id of the Sale Invoice"
+// @Param payment_id path string true  "The id of the Payment"
+// @Success 204 string deleted
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /invoices/{id}/payments/{payment_id} [delete]
+func (server *Server) deletePayment(ctx *gin.Context) {
+	var req deletePaymentRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	arg := db.DeletePaymentParams{
+		ID:            req.ID,
+		SaleInvoiceID: req.SaleInvoiceID,
+	}
+	rows, err := server.store.DeletePayment(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+	if rows == 0 {
+		ctx.JSON(http.StatusNotFound, errorResponse(codeNotFound, sql.ErrNoRows))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, "deleted")
+}
+
+// getSaleInvoiceBalance godoc
+// @Summary  GET the balance of a Sale Invoice
+// @Description  GET the total, amount paid, outstanding amount and payment status (unpaid, partial, paid or overpaid)
+// @Description  of a Sale Invoice
+// @Tags Payment
+// @ID balance-SaleInvoice
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id of the Sale Invoice"
+// @Success 200 {object} SaleInvoiceBalanceResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /invoices/{id}/balance [get]
+func (server *Server) getSaleInvoiceBalance(ctx *gin.Context) {
+	var req getSaleInvoiceRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	balance, err := server.store.GetSaleInvoiceBalance(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newSaleInvoiceBalanceResponse(balance))
+}
+
+// swagger:model OutstandingInvoicesResponse
+type OutstandingInvoicesResponse struct {
+	// The number of invoices and amount outstanding per age of the invoices, in days
+	Buckets []db.ListOutstandingInvoiceAgingRow `json:"buckets"`
+	// The invoices not fully paid, oldest first
+	Invoices []SaleInvoiceBalanceResponse `json:"invoices"`
+}
+
+// listOutstandingInvoices godoc
+// @Summary list the unpaid Sale Invoices
+// @Description GET the Sale Invoices with an outstanding balance, oldest first, with the amount outstanding
+// @Description aged in 0-30, 31-60 and 60+ days buckets
+// @Tags Payment
+// @ID outstanding-SaleInvoice
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} OutstandingInvoicesResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /invoices/outstanding [get]
+func (server *Server) listOutstandingInvoices(ctx *gin.Context) {
+	invoices, err := server.store.ListOutstandingInvoices(ctx)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	aging, err := server.store.ListOutstandingInvoiceAging(ctx)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	// report every bucket, even the ones without any invoice
+	buckets := make([]db.ListOutstandingInvoiceAgingRow, 0, len(agingBuckets))
+	for _, name := range agingBuckets {
+		bucket := db.ListOutstandingInvoiceAgingRow{AgingBucket: name, Outstanding: "0"}
+		for _, row := range aging {
+			if row.AgingBucket == name {
+				bucket = row
+			}
+		}
+		buckets = append(buckets, bucket)
+	}
+
+	rsp := OutstandingInvoicesResponse{
+		Buckets:  buckets,
+		Invoices: make([]SaleInvoiceBalanceResponse, 0, len(invoices)),
+	}
+	for _, invoice := range invoices {
+		rsp.Invoices = append(rsp.Invoices, newSaleInvoiceBalanceResponse(invoice))
+	}
+	ctx.JSON(http.StatusOK, rsp)
+}
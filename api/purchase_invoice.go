@@ -0,0 +1,460 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	db "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/sqlc"
+	"github.com/gin-gonic/gin"
+)
+
+const dateLayout = "2006-01-02"
+
+// nullDate parses a date already validated by the binding, an empty date is NULL
+func nullDate(date string) sql.NullTime {
+	t, err := time.Parse(dateLayout, date)
+	return sql.NullTime{Time: t, Valid: err == nil}
+}
+
+// swagger:model PurchaseInvoiceResponse
+type PurchaseInvoiceResponse struct {
+	// The ID of a Purchase Invoice
+	// example: 1
+	ID int32 `json:"id"`
+	// The ID of the Supplier
+	// example: 1
+	SupplierID int32 `json:"supplier_id"`
+	// The reference of the invoice given by the Supplier
+	// example: FA-2022-0042
+	Ref string `json:"ref"`
+	// The date of the invoice
+	// example: 2022-06-01T00:00:00Z
+	Date *time.Time `json:"date"`
+	// The sum of the lines of the invoice
+	// example: 24000.00
+	Total string `json:"total"`
+	// The lines of the invoice
+	Lines []db.PurchaseDetail `json:"lines,omitempty"`
+}
+
+func newPurchaseInvoiceResponse(invoice db.PurchaseInvoice, lines []db.PurchaseDetail) PurchaseInvoiceResponse {
+	return PurchaseInvoiceResponse{
+		ID:         invoice.ID,
+		SupplierID: invoice.SupplierID,
+		Ref:        invoice.Ref,
+		Date:       nullTime(invoice.Date),
+		Total:      invoice.Total,
+		Lines:      lines,
+	}
+}
+
+// getPurchaseInvoiceWithLines loads a purchase invoice, whose total is kept up to date by the database, and its lines
+func (server *Server) getPurchaseInvoiceWithLines(ctx context.Context, id int32) (PurchaseInvoiceResponse, error) {
+	invoice, err := server.store.GetPurchaseInvoice(ctx, id)
+	if err != nil {
+		return PurchaseInvoiceResponse{}, err
+	}
+
+	lines, err := server.store.ListPurchaseDetails(ctx, id)
+	if err != nil {
+		return PurchaseInvoiceResponse{}, err
+	}
+
+	return newPurchaseInvoiceResponse(invoice, lines), nil
+}
+
+// swagger:model purchaseDetailRequest
+type purchaseDetailRequest struct {
+	// The ID of the Part purchased
+	// example: 1
+	PartID int32 `json:"partId" binding:"required,min=1"`
+	// The quantity purchased
+	// example: 20
+	Quantity int32 `json:"quantity" binding:"required,min=1"`
+	// The unit price paid
+	// example: 1200.00
+	Price string `json:"price" binding:"required,numeric"`
+}
+
+// swagger:model createPurchaseInvoiceRequest
+type createPurchaseInvoiceRequest struct {
+	// The ID of the Supplier
+	// example: 1
+	SupplierID int32 `json:"supplierId" binding:"required,min=1"`
+	// The reference of the invoice given by the Supplier
+	// example: FA-2022-0042
+	Ref string `json:"ref" binding:"required"`
+	// The date of the invoice
+	// example: 2022-06-01
+	Date string `json:"date" binding:"omitempty,datetime=2006-01-02"`
+	// The lines of the invoice
+	Lines []purchaseDetailRequest `json:"lines" binding:"dive"`
+}
+
+// createPurchaseInvoice godoc
+// @Summary Create new Purchase Invoice
+// @Description Record a Purchase Invoice from a Supplier with its lines, the purchased Parts are added to stock
+// @ID create-PurchaseInvoice
+// @Tags PurchaseInvoice
+// @Accept  json
+// @Produce  json
+// @Param Body body createPurchaseInvoiceRequest true "The body to create a Purchase Invoice"
+// @Success 200 {object} PurchaseInvoiceResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /purchase-invoices [post]
+func (server *Server) createPurchaseInvoice(ctx *gin.Context) {
+	var req createPurchaseInvoiceRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	arg := db.CreatePurchaseInvoiceTxParams{
+		SupplierID: req.SupplierID,
+		Ref:        req.Ref,
+		Date:       nullDate(req.Date),
+		Lines:      make([]db.CreatePurchaseDetailParams, 0, len(req.Lines)),
+	}
+	for _, line := range req.Lines {
+		arg.Lines = append(arg.Lines, db.CreatePurchaseDetailParams{
+			PartID:   line.PartID,
+			Quantity: line.Quantity,
+			Price:    line.Price,
+		})
+	}
+	result, err := server.store.CreatePurchaseInvoiceTx(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	rsp := newPurchaseInvoiceResponse(result.Invoice, result.Lines)
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+type getPurchaseInvoiceRequest struct {
+	ID int32 `uri:"id" binding:"required,min=1"`
+}
+
+// getPurchaseInvoice godoc
+// @Summary  GET Purchase Invoice
+// @Description  GET  Purchase Invoice by it's id, with its lines
+// @Tags PurchaseInvoice
+// @ID get-PurchaseInvoice
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id to get a Purchase Invoice"
+// @Success 200 {object} PurchaseInvoiceResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /purchase-invoices/{id} [get]
+func (server *Server) getPurchaseInvoice(ctx *gin.Context) {
+	var req getPurchaseInvoiceRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	rsp, err := server.getPurchaseInvoiceWithLines(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+// swagger:model ListPurchaseInvoicesRequest
+type ListPurchaseInvoicesRequest struct {
+	pageRequest
+}
+
+// listPurchaseInvoices godoc
+// @Summary list all Purchase Invoices
+// @Description GET list of all Purchase Invoices, without their lines
+// @Tags PurchaseInvoice
+// @ID list-PurchaseInvoice
+// @Accept  json
+// @Produce  json
+// @Param cursor query string false "The next_cursor of the previous page, none for the first page"
+// @Param page_size query int false "The number of Purchase Invoices per page, 20 by default"
+// @Success 200 {object} PageResponse{items=[]PurchaseInvoiceResponse}
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /purchase-invoices [get]
+func (server *Server) listPurchaseInvoices(ctx *gin.Context) {
+	var req ListPurchaseInvoicesRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+	cursor, err := decodeCursor(req.Cursor)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	arg := db.ListPurchaseInvoicesParams{
+		AfterID: int32(cursor.AfterID),
+		Limit:   req.size() + 1,
+	}
+	invoices, err := server.store.ListPurchaseInvoices(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+	totalCount, err := server.store.CountPurchaseInvoices(ctx)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+	invoices, nextCursor := nextPage(invoices, req.size(), func(invoice db.PurchaseInvoice) pageCursor {
+		return pageCursor{AfterID: int64(invoice.ID)}
+	})
+
+	rsp := make([]PurchaseInvoiceResponse, 0, len(invoices))
+	for _, invoice := range invoices {
+		rsp = append(rsp, newPurchaseInvoiceResponse(invoice, nil))
+	}
+	ctx.JSON(http.StatusOK, PageResponse{Items: rsp, NextCursor: nextCursor, TotalCount: totalCount})
+}
+
+// swagger:model updatePurchaseInvoiceRequest
+type updatePurchaseInvoiceRequest struct {
+	// The ID of the Supplier
+	// example: 1
+	SupplierID int32 `json:"supplierId" binding:"required,min=1"`
+	// The reference of the invoice given by the Supplier
+	// example: FA-2022-0042
+	Ref string `json:"ref" binding:"required"`
+	// The date of the invoice
+	// example: 2022-06-01
+	Date string `json:"date" binding:"omitempty,datetime=2006-01-02"`
+}
+
+// updatePurchaseInvoice godoc
+// @Summary update  Purchase Invoice
+// @Description update the supplier, reference and date of a Purchase Invoice, use the lines api to change its lines
+// @Tags PurchaseInvoice
+// @ID update-PurchaseInvoice
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id to update a Purchase Invoice"
+// @Param Body body updatePurchaseInvoiceRequest true "The body to update a Purchase Invoice"
+// @Success 200 {object} PurchaseInvoiceResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /purchase-invoices/{id} [put]
+func (server *Server) updatePurchaseInvoice(ctx *gin.Context) {
+	var uri getPurchaseInvoiceRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	var req updatePurchaseInvoiceRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	arg := db.UpdatePurchaseInvoiceParams{
+		ID:         uri.ID,
+		SupplierID: req.SupplierID,
+		Ref:        req.Ref,
+		Date:       nullDate(req.Date),
+	}
+	_, err := server.store.UpdatePurchaseInvoice(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	rsp, err := server.getPurchaseInvoiceWithLines(ctx, uri.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+// deletePurchaseInvoice godoc
+// @Summary DELETE a Purchase Invoice
+// @Description use this api to delete a purchase invoice and its lines by it's id, refused when parts it purchased were already used
+// @Tags PurchaseInvoice
+// @ID delete-PurchaseInvoice
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id to delete a Purchase Invoice"
+// @Success 204 string deleted
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /purchase-invoices/{id} [delete]
+func (server *Server) deletePurchaseInvoice(ctx *gin.Context) {
+	var req getPurchaseInvoiceRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	if err := server.store.DeletePurchaseInvoiceTx(ctx, req.ID); err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, "deleted")
+}
+
+// addPurchaseDetail godoc
+// @Summary add a line to a Purchase Invoice
+// @Description add a line to a Purchase Invoice, the total of the invoice is updated
+// @Tags PurchaseInvoice
+// @ID add-PurchaseDetail
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id of the Purchase Invoice"
+// @Param Body body purchaseDetailRequest true "The line to add"
+// @Success 200 {object} PurchaseInvoiceResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /purchase-invoices/{id}/lines [post]
+func (server *Server) addPurchaseDetail(ctx *gin.Context) {
+	var uri getPurchaseInvoiceRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	var req purchaseDetailRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	arg := db.CreatePurchaseDetailParams{
+		PartID:            req.PartID,
+		PurchaseInvoiceID: uri.ID,
+		Quantity:          req.Quantity,
+		Price:             req.Price,
+	}
+	_, err := server.store.AddPurchaseDetailTx(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	rsp, err := server.getPurchaseInvoiceWithLines(ctx, uri.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+type purchaseDetailURIRequest struct {
+	PurchaseInvoiceID int32 `uri:"id" binding:"required,min=1"`
+	ID                int32 `uri:"line_id" binding:"required,min=1"`
+}
+
+// updatePurchaseDetail godoc
+// @Summary update a line of a Purchase Invoice
+// @Description update a line of a Purchase Invoice, the total of the invoice is updated. Lowering the quantity or changing the part is refused when the parts were already used.
+// @Tags PurchaseInvoice
+// @ID update-PurchaseDetail
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id of the Purchase Invoice"
+// @Param line_id path string true  "The id of the line"
+// @Param Body body purchaseDetailRequest true "The line"
+// @Success 200 {object} PurchaseInvoiceResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /purchase-invoices/{id}/lines/{line_id} [put]
+func (server *Server) updatePurchaseDetail(ctx *gin.Context) {
+	var uri purchaseDetailURIRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	var req purchaseDetailRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	arg := db.UpdatePurchaseDetailParams{
+		ID:                uri.ID,
+		PurchaseInvoiceID: uri.PurchaseInvoiceID,
+		PartID:            req.PartID,
+		Quantity:          req.Quantity,
+		Price:             req.Price,
+	}
+	_, err := server.store.UpdatePurchaseDetailTx(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	rsp, err := server.getPurchaseInvoiceWithLines(ctx, uri.PurchaseInvoiceID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+// deletePurchaseDetail godoc
+// @Summary remove a line from a Purchase Invoice
+// @Description remove a line from a Purchase Invoice, the total of the invoice is updated. Refused when the parts were already used.
+// @Tags PurchaseInvoice
+// @ID delete-PurchaseDetail
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id of the Purchase Invoice"
+// @Param line_id path string true  "The id of the line"
+// @Success 204 string deleted
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /purchase-invoices/{id}/lines/{line_id} [delete]
+func (server *Server) deletePurchaseDetail(ctx *gin.Context) {
+	var req purchaseDetailURIRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	arg := db.DeletePurchaseDetailParams{
+		ID:                req.ID,
+		PurchaseInvoiceID: req.PurchaseInvoiceID,
+	}
+	if err := server.store.DeletePurchaseDetailTx(ctx, arg); err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, "deleted")
+}
@@ -0,0 +1,180 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	db "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/sqlc"
+	"github.com/gin-gonic/gin"
+)
+
+// swagger:model SaleInvoiceResponse
+type SaleInvoiceResponse struct {
+	// The ID of a Sale Invoice
+	// example: 1
+	ID int32 `json:"id"`
+	// The ID of the Service Order invoiced
+	// example: 1
+	ServiceOrderID int32 `json:"service_order_id"`
+	// The day the invoice was issued
+	// example: 2022-06-03T00:00:00Z
+	Date *time.Time `json:"date"`
+	// The sequential reference of the invoice
+	// example: INV-000042
+	Ref string `json:"ref"`
+	// The sum of the lines of the invoice
+	// example: 14500.00
+	Total string `json:"total"`
+	// The services and parts invoiced, with the prices at the time the invoice was issued
+	Lines []db.SaleInvoiceLine `json:"lines,omitempty"`
+}
+
+func newSaleInvoiceResponse(invoice db.SaleInvoice, lines []db.SaleInvoiceLine) SaleInvoiceResponse {
+	return SaleInvoiceResponse{
+		ID:             invoice.ID,
+		ServiceOrderID: invoice.ServiceOrderID,
+		Date:           nullTime(invoice.Date),
+		Ref:            invoice.Ref,
+		Total:          invoice.Total,
+		Lines:          lines,
+	}
+}
+
+// getSaleInvoiceWithLines loads a sale invoice and its lines
+func (server *Server) getSaleInvoiceWithLines(ctx context.Context, id int32) (SaleInvoiceResponse, error) {
+	invoice, err := server.store.GetSaleInvoice(ctx, id)
+	if err != nil {
+		return SaleInvoiceResponse{}, err
+	}
+
+	lines, err := server.store.ListSaleInvoiceLines(ctx, id)
+	if err != nil {
+		return SaleInvoiceResponse{}, err
+	}
+
+	return newSaleInvoiceResponse(invoice, lines), nil
+}
+
+// createSaleInvoice godoc
+// @Summary invoice a Service Order
+// @Description Issue the Sale Invoice of a ready or returned Service Order. The service and part lines of the order
+// @Description are copied with their current prices so later price changes do not alter the invoice.
+// @Tags SaleInvoice
+// @ID create-SaleInvoice
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id of the Service Order"
+// @Success 200 {object} SaleInvoiceResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /service-orders/{id}/invoice [post]
+func (server *Server) createSaleInvoice(ctx *gin.Context) {
+	var req getServiceOrderRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	result, err := server.store.CreateSaleInvoiceTx(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	rsp := newSaleInvoiceResponse(result.Invoice, result.Lines)
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+type getSaleInvoiceRequest struct {
+	ID int32 `uri:"id" binding:"required,min=1"`
+}
+
+// getSaleInvoice godoc
+// @Summary  GET Sale Invoice
+// @Description  GET  Sale Invoice by it's id, with its lines
+// @Tags SaleInvoice
+// @ID get-SaleInvoice
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id to get a Sale Invoice"
+// @Success 200 {object} SaleInvoiceResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /invoices/{id} [get]
+func (server *Server) getSaleInvoice(ctx *gin.Context) {
+	var req getSaleInvoiceRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	rsp, err := server.getSaleInvoiceWithLines(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+// swagger:model ListSaleInvoicesRequest
+type ListSaleInvoicesRequest struct {
+	pageRequest
+}
+
+// listSaleInvoices godoc
+// @Summary list all Sale Invoices
+// @Description GET list of all Sale Invoices, without their lines
+// @Tags SaleInvoice
+// @ID list-SaleInvoice
+// @Accept  json
+// @Produce  json
+// @Param cursor query string false "The next_cursor of the previous page, none for the first page"
+// @Param page_size query int false "The number of Sale Invoices per page, 20 by default"
+// @Success 200 {object} PageResponse{items=[]SaleInvoiceResponse}
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /invoices [get]
+func (server *Server) listSaleInvoices(ctx *gin.Context) {
+	var req ListSaleInvoicesRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+	cursor, err := decodeCursor(req.Cursor)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	arg := db.ListSaleInvoicesParams{
+		AfterID: int32(cursor.AfterID),
+		Limit:   req.size() + 1,
+	}
+	invoices, err := server.store.ListSaleInvoices(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+	totalCount, err := server.store.CountSaleInvoices(ctx)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+	invoices, nextCursor := nextPage(invoices, req.size(), func(invoice db.SaleInvoice) pageCursor {
+		return pageCursor{AfterID: int64(invoice.ID)}
+	})
+
+	rsp := make([]SaleInvoiceResponse, 0, len(invoices))
+	for _, invoice := range invoices {
+		rsp = append(rsp, newSaleInvoiceResponse(invoice, nil))
+	}
+	ctx.JSON(http.StatusOK, PageResponse{Items: rsp, NextCursor: nextCursor, TotalCount: totalCount})
+}
@@ -1,37 +1,179 @@
 package api
 
 import (
+	"fmt"
+	"time"
+
 	db "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/sqlc"
+	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/token"
+	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/util"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
 	swaggerFiles "github.com/swaggo/files"     // swagger embed files
 	ginSwagger "github.com/swaggo/gin-swagger" // gin-swagger middleware
 )
 
 type Server struct {
-	store  *db.Queries
-	router *gin.Engine
+	config     util.Config
+	store      db.Store
+	tokenMaker token.Maker
+	router     *gin.Engine
+	// the days of the schedule start and end at midnight in the timezone of the shop
+	location *time.Location
 }
 
-func NewServer(store *db.Queries) *Server {
-	server := &Server{store: store}
+func NewServer(config util.Config, store db.Store) (*Server, error) {
+	tokenMaker, err := token.NewMaker(config.TokenType, config.TokenSymmetricKey)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create token maker: %w", err)
+	}
+
+	if !util.IsSupportedPhoneRegion(config.DefaultPhoneRegion) {
+		return nil, fmt.Errorf("unsupported default phone region %q", config.DefaultPhoneRegion)
+	}
+
+	if config.BayCount < 1 {
+		return nil, fmt.Errorf("the workshop needs at least one bay, got BAY_COUNT=%d", config.BayCount)
+	}
+
+	location, err := time.LoadLocation(config.ShopTimezone)
+	if err != nil {
+		return nil, fmt.Errorf("unknown shop timezone %q: %w", config.ShopTimezone, err)
+	}
+
+	server := &Server{
+		config:     config,
+		store:      store,
+		tokenMaker: tokenMaker,
+		location:   location,
+	}
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterValidation("phone", phoneValidator(config.DefaultPhoneRegion))
+	}
+
+	server.setupRouter()
+	return server, nil
+}
+
+func (server *Server) setupRouter() {
 	router := gin.Default()
-	server.router = router
 
-	router.GET("/customers/:id", server.getCustomer)
-	router.POST("/customers", server.createCustomer)
-	router.PUT("/customers/:id", server.updateCustomer)
-	router.DELETE("/customers/:id", server.deleteCustomer)
-	router.GET("/customers", server.listCustomers)
+	router.POST("/users/setup", server.setupFirstAdmin)
+	router.POST("/users/login", server.loginUser)
+	router.POST("/users/logout", server.logoutUser)
+	router.POST("/tokens/renew_access", server.renewAccessToken)
+
+	// every other route needs a logged in user, the roles allowed are checked per route
+	authRoutes := router.Group("/").Use(authMiddleware(server.tokenMaker))
+	admin := requireRole(util.RoleAdmin)
+	frontDesk := requireRole(util.RoleAdmin, util.RoleFrontDesk)
+	workshop := requireRole(util.RoleAdmin, util.RoleFrontDesk, util.RoleMechanic)
+	accounting := requireRole(util.RoleAdmin, util.RoleAccountant)
+	billing := requireRole(util.RoleAdmin, util.RoleFrontDesk, util.RoleAccountant)
+
+	authRoutes.POST("/users", admin, server.createUser)
+	authRoutes.GET("/users", admin, server.listUsers)
+
+	authRoutes.GET("/customers/:id", server.getCustomer)
+	authRoutes.POST("/customers", frontDesk, server.createCustomer)
+	authRoutes.PUT("/customers/:id", frontDesk, server.updateCustomer)
+	authRoutes.PATCH("/customers/:id", frontDesk, server.patchCustomer)
+	authRoutes.DELETE("/customers/:id", frontDesk, server.deleteCustomer)
+	authRoutes.POST("/customers/:id/restore", frontDesk, server.restoreCustomer)
+	authRoutes.POST("/customers/:id/purge", admin, server.purgeCustomer)
+	authRoutes.GET("/customers", server.listCustomers)
+	authRoutes.GET("/customers/search", server.searchCustomers)
+	authRoutes.GET("/customers/:id/cars", server.listCustomerCars)
+
+	authRoutes.GET("/cars/:id", server.getCar)
+	authRoutes.POST("/cars", frontDesk, server.createCar)
+	authRoutes.PUT("/cars/:id", frontDesk, server.updateCar)
+	authRoutes.DELETE("/cars/:id", frontDesk, server.deleteCar)
+	authRoutes.POST("/cars/:id/restore", frontDesk, server.restoreCar)
+	authRoutes.GET("/cars/:id/history", server.getCarHistory)
+	authRoutes.GET("/cars", server.listCars)
+
+	authRoutes.GET("/service-orders/:id", server.getServiceOrder)
+	authRoutes.POST("/service-orders", frontDesk, server.createServiceOrder)
+	authRoutes.PUT("/service-orders/:id", frontDesk, server.updateServiceOrder)
+	authRoutes.PATCH("/service-orders/:id", frontDesk, server.patchServiceOrder)
+	authRoutes.DELETE("/service-orders/:id", frontDesk, server.deleteServiceOrder)
+	authRoutes.GET("/service-orders", server.listServiceOrders)
+	authRoutes.POST("/service-orders/:id/transitions", workshop, server.transitionServiceOrder)
+	authRoutes.PUT("/service-orders/:id/transitions", workshop, server.transitionServiceOrder)
+	authRoutes.GET("/service-orders/:id/mechanics", server.listServiceOrderMechanics)
+	authRoutes.POST("/service-orders/:id/mechanics", frontDesk, server.assignMechanic)
+	authRoutes.DELETE("/service-orders/:id/mechanics/:mechanic_id", frontDesk, server.unassignMechanic)
+	authRoutes.GET("/service-orders/:id/parts", server.listServiceOrderParts)
+	authRoutes.POST("/service-orders/:id/parts", workshop, server.addPartDetail)
+	authRoutes.DELETE("/service-orders/:id/parts/:part_id", workshop, server.deletePartDetail)
+	authRoutes.GET("/service-orders/:id/services", server.listServiceOrderServices)
+	authRoutes.POST("/service-orders/:id/services", workshop, server.addServiceDetail)
+	authRoutes.DELETE("/service-orders/:id/services/:service_id", workshop, server.deleteServiceDetail)
+	authRoutes.POST("/service-orders/:id/services/:service_id/transitions", workshop, server.transitionServiceDetail)
+	authRoutes.GET("/service-orders/:id/labor", server.listServiceOrderLabor)
+	authRoutes.POST("/service-orders/:id/invoice", billing, server.createSaleInvoice)
+
+	authRoutes.GET("/appointments/:id", server.getAppointment)
+	authRoutes.POST("/appointments", frontDesk, server.createAppointment)
+	authRoutes.POST("/appointments/:id/cancel", frontDesk, server.cancelAppointment)
+	authRoutes.POST("/appointments/:id/arrive", frontDesk, server.arriveAppointment)
+	authRoutes.GET("/schedule", server.getSchedule)
+
+	authRoutes.GET("/mechanics/:id", server.getMechanic)
+	authRoutes.POST("/mechanics", admin, server.createMechanic)
+	authRoutes.PUT("/mechanics/:id", admin, server.updateMechanic)
+	authRoutes.DELETE("/mechanics/:id", admin, server.deleteMechanic)
+	authRoutes.GET("/mechanics", server.listMechanics)
+	authRoutes.GET("/mechanics/:id/workload", server.getMechanicWorkload)
+	authRoutes.POST("/mechanics/:id/clock-in", workshop, server.clockIn)
+	authRoutes.POST("/mechanics/:id/clock-out", workshop, server.clockOut)
+
+	authRoutes.GET("/parts/:id", server.getPart)
+	authRoutes.POST("/parts", accounting, server.createPart)
+	authRoutes.PUT("/parts/:id", accounting, server.updatePart)
+	authRoutes.DELETE("/parts/:id", accounting, server.deletePart)
+	authRoutes.GET("/parts", server.listParts)
+	authRoutes.GET("/parts/:id/stock", server.getPartStock)
+	authRoutes.GET("/parts/low-stock", server.listLowStockParts)
+
+	authRoutes.GET("/services/:id", server.getService)
+	authRoutes.POST("/services", admin, server.createService)
+	authRoutes.PUT("/services/:id", admin, server.updateService)
+	authRoutes.DELETE("/services/:id", admin, server.deleteService)
+	authRoutes.GET("/services", server.listServices)
+	authRoutes.GET("/reports/labor", admin, server.getLaborReport)
+
+	authRoutes.GET("/suppliers/:id", accounting, server.getSupplier)
+	authRoutes.POST("/suppliers", accounting, server.createSupplier)
+	authRoutes.PUT("/suppliers/:id", accounting, server.updateSupplier)
+	authRoutes.DELETE("/suppliers/:id", accounting, server.deleteSupplier)
+	authRoutes.GET("/suppliers", accounting, server.listSuppliers)
+
+	authRoutes.GET("/purchase-invoices/:id", accounting, server.getPurchaseInvoice)
+	authRoutes.POST("/purchase-invoices", accounting, server.createPurchaseInvoice)
+	authRoutes.PUT("/purchase-invoices/:id", accounting, server.updatePurchaseInvoice)
+	authRoutes.DELETE("/purchase-invoices/:id", accounting, server.deletePurchaseInvoice)
+	authRoutes.GET("/purchase-invoices", accounting, server.listPurchaseInvoices)
+	authRoutes.POST("/purchase-invoices/:id/lines", accounting, server.addPurchaseDetail)
+	authRoutes.PUT("/purchase-invoices/:id/lines/:line_id", accounting, server.updatePurchaseDetail)
+	authRoutes.DELETE("/purchase-invoices/:id/lines/:line_id", accounting, server.deletePurchaseDetail)
+
+	authRoutes.GET("/invoices/:id", billing, server.getSaleInvoice)
+	authRoutes.GET("/invoices", billing, server.listSaleInvoices)
+	authRoutes.GET("/invoices/outstanding", accounting, server.listOutstandingInvoices)
+	authRoutes.GET("/invoices/:id/balance", billing, server.getSaleInvoiceBalance)
+	authRoutes.GET("/invoices/:id/payments", billing, server.listInvoicePayments)
+	authRoutes.POST("/invoices/:id/payments", billing, server.createPayment)
+	authRoutes.DELETE("/invoices/:id/payments/:payment_id", accounting, server.deletePayment)
+
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
-	return server
+	server.router = router
 }
 
 // Start runs the HTTP server on a specific address.
 func (server *Server) Start(address string) error {
 	return server.router.Run(address)
 }
-
-func errorResponse(err error) gin.H {
-	return gin.H{"error": err.Error()}
-}
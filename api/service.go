@@ -0,0 +1,290 @@
+package api
+
+import (
+	"database/sql"
+	"net/http"
+
+	db "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/sqlc"
+	"github.com/gin-gonic/gin"
+)
+
+// swagger:model ServiceResponse
+type ServiceResponse struct {
+	// The ID of a Service
+	// example: 1
+	ID int32 `json:"id"`
+	// The Name of a Service
+	// example: oil change
+	Name string `json:"name"`
+	// The Description of a Service
+	// example: drain the engine oil and replace the oil filter
+	Description string `json:"description"`
+	// The time the Service takes, in minutes
+	// example: 45
+	EstimationTime int32 `json:"estimation_time"`
+	// The lowest price the Service is charged at
+	// example: 2500.00
+	MinPrice string `json:"min_price"`
+	// The highest price the Service is charged at
+	// example: 4000.00
+	MaxPrice string `json:"max_price"`
+}
+
+func newServiceResponse(service db.Service) ServiceResponse {
+	return ServiceResponse{
+		ID:             service.ID,
+		Name:           service.Name,
+		Description:    service.Description,
+		EstimationTime: service.EstimationTime,
+		MinPrice:       service.MinPrice.String,
+		MaxPrice:       service.MaxPrice.String,
+	}
+}
+
+// swagger:model createServiceRequest
+type createServiceRequest struct {
+	// The Name of a Service
+	// example: oil change
+	Name string `json:"name" binding:"required"`
+	// The Description of a Service
+	// example: drain the engine oil and replace the oil filter
+	Description string `json:"description" binding:"required"`
+	// The time the Service takes, in minutes
+	// example: 45
+	EstimationTime int32 `json:"estimationTime" binding:"required,min=1"`
+	// The lowest price the Service is charged at, the price of a service line by default
+	// example: 2500.00
+	MinPrice string `json:"minPrice" binding:"required,numeric"`
+	// The highest price the Service is charged at, at least the MinPrice
+	// example: 4000.00
+	MaxPrice string `json:"maxPrice" binding:"required,numeric"`
+}
+
+// createService godoc
+// @Summary Create new Service
+// @Description Create a new Service of the catalog
+// @ID create-Service
+// @Tags Service
+// @Accept  json
+// @Produce  json
+// @Param Body body createServiceRequest true "The body to create a Service"
+// @Success 200 {object} ServiceResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 422 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /services [post]
+func (server *Server) createService(ctx *gin.Context) {
+	var req createServiceRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	arg := db.CreateServiceParams{
+		Name:           req.Name,
+		Description:    req.Description,
+		EstimationTime: req.EstimationTime,
+		MinPrice:       sql.NullString{String: req.MinPrice, Valid: true},
+		MaxPrice:       sql.NullString{String: req.MaxPrice, Valid: true},
+	}
+	service, err := server.store.CreateService(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newServiceResponse(service))
+}
+
+type getServiceRequest struct {
+	ID int32 `uri:"id" binding:"required,min=1"`
+}
+
+// getService godoc
+// @Summary  GET Service
+// @Description  GET  Service of the catalog by it's id
+// @Tags Service
+// @ID get-Service
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id to get a Service"
+// @Success 200 {object} ServiceResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /services/{id} [get]
+func (server *Server) getService(ctx *gin.Context) {
+	var req getServiceRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	service, err := server.store.GetService(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newServiceResponse(service))
+}
+
+// swagger:model ListServicesRequest
+type ListServicesRequest struct {
+	pageRequest
+}
+
+// listServices godoc
+// @Summary list all Services
+// @Description GET list of all Services of the catalog
+// @Tags Service
+// @ID list-Service
+// @Accept  json
+// @Produce  json
+// @Param cursor query string false "The next_cursor of the previous page, none for the first page"
+// @Param page_size query int false "The number of Services per page, 20 by default"
+// @Success 200 {object} PageResponse{items=[]ServiceResponse}
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /services [get]
+func (server *Server) listServices(ctx *gin.Context) {
+	var req ListServicesRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+	cursor, err := decodeCursor(req.Cursor)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	arg := db.ListServicesParams{
+		AfterID: int32(cursor.AfterID),
+		Limit:   req.size() + 1,
+	}
+	services, err := server.store.ListServices(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+	totalCount, err := server.store.CountServices(ctx)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+	services, nextCursor := nextPage(services, req.size(), func(service db.Service) pageCursor {
+		return pageCursor{AfterID: int64(service.ID)}
+	})
+
+	rsp := make([]ServiceResponse, 0, len(services))
+	for _, service := range services {
+		rsp = append(rsp, newServiceResponse(service))
+	}
+	ctx.JSON(http.StatusOK, PageResponse{Items: rsp, NextCursor: nextCursor, TotalCount: totalCount})
+}
+
+// swagger:model updateServiceRequest
+type updateServiceRequest struct {
+	// The Name of a Service
+	// example: oil change
+	Name string `json:"name" binding:"required"`
+	// The Description of a Service
+	// example: drain the engine oil and replace the oil filter
+	Description string `json:"description" binding:"required"`
+	// The time the Service takes, in minutes
+	// example: 45
+	EstimationTime int32 `json:"estimationTime" binding:"required,min=1"`
+	// The lowest price the Service is charged at, the price of a service line by default
+	// example: 2500.00
+	MinPrice string `json:"minPrice" binding:"required,numeric"`
+	// The highest price the Service is charged at, at least the MinPrice
+	// example: 4000.00
+	MaxPrice string `json:"maxPrice" binding:"required,numeric"`
+}
+
+// updateService godoc
+// @Summary update  Service
+// @Description update a Service of the catalog, the lines already on Service Orders keep their price
+// @Tags Service
+// @ID update-Service
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id to update a Service"
+// @Param Body body updateServiceRequest true "The body to update a Service"
+// @Success 200 {object} ServiceResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 422 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /services/{id} [put]
+func (server *Server) updateService(ctx *gin.Context) {
+	var uri getServiceRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	var req updateServiceRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	arg := db.UpdateServiceParams{
+		ID:             uri.ID,
+		Name:           req.Name,
+		Description:    req.Description,
+		EstimationTime: req.EstimationTime,
+		MinPrice:       sql.NullString{String: req.MinPrice, Valid: true},
+		MaxPrice:       sql.NullString{String: req.MaxPrice, Valid: true},
+	}
+	service, err := server.store.UpdateService(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newServiceResponse(service))
+}
+
+// deleteService godoc
+// @Summary DELETE a Service
+// @Description delete a Service of the catalog, refused while Service Orders use it
+// @Tags Service
+// @ID delete-Service
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id to delete a Service"
+// @Success 204 string deleted
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 422 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /services/{id} [delete]
+func (server *Server) deleteService(ctx *gin.Context) {
+	var req getServiceRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	rows, err := server.store.DeleteService(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+	if rows == 0 {
+		ctx.JSON(http.StatusNotFound, errorResponse(codeNotFound, sql.ErrNoRows))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, "deleted")
+}
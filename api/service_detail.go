@@ -0,0 +1,269 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	db "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/sqlc"
+	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/token"
+	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/util"
+	"github.com/gin-gonic/gin"
+)
+
+var errPriceOverrideForbidden = errors.New("only an admin can override the price range of a Service")
+
+// swagger:model ServiceDetailResponse
+type ServiceDetailResponse struct {
+	// The ID of the line
+	// example: 1
+	ID int32 `json:"id"`
+	// The ID of the Service done
+	// example: 1
+	ServiceID int32 `json:"service_id"`
+	// The ID of the Service Order
+	// example: 1
+	ServiceOrderID int32 `json:"service_order_id"`
+	// The price charged
+	// example: 2500.00
+	Price string `json:"price"`
+	// The state of the work on the line
+	// example: started
+	State string `json:"state"`
+	// When the work on the line first started
+	// example: 2022-06-01T09:30:00Z
+	StartedAt *time.Time `json:"started_at"`
+	// When the work on the line last stopped, empty while it is started
+	// example: 2022-06-01T10:15:00Z
+	StoppedAt *time.Time `json:"stopped_at"`
+	// Why the price is outside the range of the Service, empty when it is not
+	// example: loyal customer discount
+	PriceOverrideReason string `json:"price_override_reason,omitempty"`
+	// The admin who allowed the price outside the range of the Service
+	// example: admin
+	PriceOverriddenBy string `json:"price_overridden_by,omitempty"`
+}
+
+func newServiceDetailResponse(detail db.ServiceDetail) ServiceDetailResponse {
+	return ServiceDetailResponse{
+		ID:                  detail.ID,
+		ServiceID:           detail.ServiceID,
+		ServiceOrderID:      detail.ServiceOrderID,
+		Price:               detail.Price.String,
+		State:               db.ServiceDetailStateName(detail.State),
+		StartedAt:           nullTime(detail.StartedAt),
+		StoppedAt:           nullTime(detail.StoppedAt),
+		PriceOverrideReason: detail.PriceOverrideReason.String,
+		PriceOverriddenBy:   detail.PriceOverriddenBy.String,
+	}
+}
+
+// swagger:model addServiceDetailRequest
+type addServiceDetailRequest struct {
+	// The ID of the Service done
+	// example: 1
+	ServiceID int32 `json:"serviceId" binding:"required,min=1"`
+	// The price charged, defaults to the min price of the Service
+	// example: 2500.00
+	Price string `json:"price" binding:"omitempty,numeric"`
+	// Charge a price outside the range of the Service, for admins only
+	// example: false
+	OverridePrice bool `json:"overridePrice"`
+	// Why the price is outside the range of the Service, required with OverridePrice
+	// example: loyal customer discount
+	OverrideReason string `json:"overrideReason" binding:"required_if=OverridePrice true,max=255"`
+}
+
+// addServiceDetail godoc
+// @Summary add a Service to a Service Order
+// @Description add a Service line to a Service Order, its price must be within the range of the Service unless an admin overrides it with a reason
+// @Tags Service
+// @ID add-ServiceDetail
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id of the Service Order"
+// @Param Body body addServiceDetailRequest true "The Service done"
+// @Success 200 {object} ServiceDetailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /service-orders/{id}/services [post]
+func (server *Server) addServiceDetail(ctx *gin.Context) {
+	var uri getServiceOrderRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	var req addServiceDetailRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	arg := db.AddServiceDetailTxParams{
+		ServiceOrderID: uri.ID,
+		ServiceID:      req.ServiceID,
+		Price:          req.Price,
+	}
+	if req.OverridePrice {
+		payload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+		if payload.Role != util.RoleAdmin {
+			ctx.JSON(http.StatusForbidden, errorResponse(codeForbidden, errPriceOverrideForbidden))
+			return
+		}
+		arg.OverriddenBy = payload.Username
+		arg.OverrideReason = req.OverrideReason
+	}
+
+	detail, err := server.store.AddServiceDetailTx(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newServiceDetailResponse(detail))
+}
+
+// listServiceOrderServices godoc
+// @Summary list the Services of a Service Order
+// @Description GET the Service lines of a Service Order
+// @Tags Service
+// @ID list-ServiceDetail
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id of the Service Order"
+// @Success 200 {array} ServiceDetailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /service-orders/{id}/services [get]
+func (server *Server) listServiceOrderServices(ctx *gin.Context) {
+	var req getServiceOrderRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	details, err := server.store.ListServiceOrderServices(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	rsp := make([]ServiceDetailResponse, 0, len(details))
+	for _, detail := range details {
+		rsp = append(rsp, newServiceDetailResponse(detail))
+	}
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+type serviceDetailRequest struct {
+	ServiceOrderID int32 `uri:"id" binding:"required,min=1"`
+	ServiceID      int32 `uri:"service_id" binding:"required,min=1"`
+}
+
+// swagger:model transitionServiceDetailRequest
+type transitionServiceDetailRequest struct {
+	// The state to move the Service line to
+	// example: started
+	State string `json:"state" binding:"required,oneof=pending started paused done declined"`
+}
+
+// swagger:model TransitionServiceDetailResponse
+type TransitionServiceDetailResponse struct {
+	// The Service line after the transition
+	ServiceDetail ServiceDetailResponse `json:"service_detail"`
+	// The Service Order of the line, ready when its last line was finished
+	ServiceOrder ServiceOrderResponse `json:"service_order"`
+}
+
+// transitionServiceDetail godoc
+// @Summary move a Service line to another state
+// @Description pending -> started <-> paused, a started or paused line can be done and the customer can decline a pending or paused line.
+// @Description The Service Order moves from in_progress to ready when all its lines are done or declined.
+// @Tags Service
+// @ID transition-ServiceDetail
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id of the Service Order"
+// @Param service_id path string true  "The id of the Service"
+// @Param Body body transitionServiceDetailRequest true "The state to move to"
+// @Success 200 {object} TransitionServiceDetailResponse
+// @Header 200 {string} ETag "The version of the Service Order, to send in If-Match"
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /service-orders/{id}/services/{service_id}/transitions [post]
+func (server *Server) transitionServiceDetail(ctx *gin.Context) {
+	var uri serviceDetailRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	var req transitionServiceDetailRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+	to, _ := db.ParseServiceDetailState(req.State)
+
+	arg := db.TransitionServiceDetailTxParams{
+		ServiceOrderID: uri.ServiceOrderID,
+		ServiceID:      uri.ServiceID,
+		State:          to,
+	}
+	result, err := server.store.TransitionServiceDetailTx(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	setETag(ctx, result.ServiceOrder.Version)
+	ctx.JSON(http.StatusOK, TransitionServiceDetailResponse{
+		ServiceDetail: newServiceDetailResponse(result.ServiceDetail),
+		ServiceOrder:  newServiceOrderResponse(result.ServiceOrder),
+	})
+}
+
+// deleteServiceDetail godoc
+// @Summary remove a Service from a Service Order
+// @Description remove a Service line no mechanic worked on from an open Service Order that was not invoiced.
+// @Description The order in progress moves to ready when the other lines are all done or declined.
+// @Tags Service
+// @ID delete-ServiceDetail
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id of the Service Order"
+// @Param service_id path string true  "The id of the Service"
+// @Success 204 string deleted
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /service-orders/{id}/services/{service_id} [delete]
+func (server *Server) deleteServiceDetail(ctx *gin.Context) {
+	var req serviceDetailRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	arg := db.DeleteServiceDetailParams{
+		ServiceOrderID: req.ServiceOrderID,
+		ServiceID:      req.ServiceID,
+	}
+	if err := server.store.DeleteServiceDetailTx(ctx, arg); err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, "deleted")
+}
@@ -0,0 +1,298 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockdb "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/mock"
+	db "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/sqlc"
+	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/util"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddServiceDetailAPI(t *testing.T) {
+	orderID := int32(util.RandomInt(1, 1000))
+	serviceID := int32(util.RandomInt(1, 1000))
+	username := util.RandomName()
+	detail := db.ServiceDetail{
+		ID:             int32(util.RandomInt(1, 1000)),
+		ServiceID:      serviceID,
+		ServiceOrderID: orderID,
+		Price:          sql.NullString{String: "2500.00", Valid: true},
+		State:          1,
+	}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		role          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"serviceId": serviceID},
+			role: util.RoleMechanic,
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.AddServiceDetailTxParams{ServiceOrderID: orderID, ServiceID: serviceID}
+				store.EXPECT().
+					AddServiceDetailTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(detail, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got ServiceDetailResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &got))
+				require.Equal(t, newServiceDetailResponse(detail), got)
+			},
+		},
+		{
+			name: "OutOfRange",
+			body: gin.H{"serviceId": serviceID, "price": "99999"},
+			role: util.RoleFrontDesk,
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.AddServiceDetailTxParams{ServiceOrderID: orderID, ServiceID: serviceID, Price: "99999"}
+				store.EXPECT().
+					AddServiceDetailTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.ServiceDetail{}, &db.ConflictError{})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "AdminOverride",
+			body: gin.H{"serviceId": serviceID, "price": "99999", "overridePrice": true, "overrideReason": "rebuilt gearbox"},
+			role: util.RoleAdmin,
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.AddServiceDetailTxParams{
+					ServiceOrderID: orderID,
+					ServiceID:      serviceID,
+					Price:          "99999",
+					OverriddenBy:   username,
+					OverrideReason: "rebuilt gearbox",
+				}
+				store.EXPECT().
+					AddServiceDetailTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(detail, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "OverrideForbidden",
+			body: gin.H{"serviceId": serviceID, "price": "99999", "overridePrice": true, "overrideReason": "rebuilt gearbox"},
+			role: util.RoleFrontDesk,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					AddServiceDetailTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeForbidden)
+			},
+		},
+		{
+			name: "OverrideWithoutReason",
+			body: gin.H{"serviceId": serviceID, "price": "99999", "overridePrice": true},
+			role: util.RoleAdmin,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					AddServiceDetailTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInvalidRequest)
+			},
+		},
+		{
+			name: "InvalidPrice",
+			body: gin.H{"serviceId": serviceID, "price": "cheap"},
+			role: util.RoleFrontDesk,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					AddServiceDetailTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInvalidRequest)
+			},
+		},
+		{
+			name: "AccountantForbidden",
+			body: gin.H{"serviceId": serviceID},
+			role: util.RoleAccountant,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					AddServiceDetailTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeForbidden)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/service-orders/%d/services", orderID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, username, tc.role, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestTransitionServiceDetailAPI(t *testing.T) {
+	orderID := int32(util.RandomInt(1, 1000))
+	serviceID := int32(util.RandomInt(1, 1000))
+	result := db.TransitionServiceDetailTxResult{
+		ServiceDetail: db.ServiceDetail{
+			ID:             int32(util.RandomInt(1, 1000)),
+			ServiceID:      serviceID,
+			ServiceOrderID: orderID,
+			Price:          sql.NullString{String: "2500.00", Valid: true},
+			State:          db.ServiceDetailDone,
+			StartedAt:      sql.NullTime{Time: time.Now().Add(-time.Hour).UTC().Truncate(time.Second), Valid: true},
+			StoppedAt:      sql.NullTime{Time: time.Now().UTC().Truncate(time.Second), Valid: true},
+		},
+		ServiceOrder: db.ServiceOrder{
+			ID:      orderID,
+			State:   db.ServiceOrderReady,
+			Version: 3,
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"state": "done"},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.TransitionServiceDetailTxParams{
+					ServiceOrderID: orderID,
+					ServiceID:      serviceID,
+					State:          db.ServiceDetailDone,
+				}
+				store.EXPECT().
+					TransitionServiceDetailTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(result, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, etag(result.ServiceOrder.Version), recorder.Header().Get("ETag"))
+
+				var got TransitionServiceDetailResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &got))
+				require.Equal(t, "done", got.ServiceDetail.State)
+				require.Equal(t, "ready", got.ServiceOrder.State)
+				require.True(t, result.ServiceDetail.StoppedAt.Time.Equal(*got.ServiceDetail.StoppedAt))
+			},
+		},
+		{
+			name: "NotAllowed",
+			body: gin.H{"state": "started"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					TransitionServiceDetailTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TransitionServiceDetailTxResult{}, &db.ConflictError{})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "NotFound",
+			body: gin.H{"state": "started"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					TransitionServiceDetailTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TransitionServiceDetailTxResult{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeNotFound)
+			},
+		},
+		{
+			name: "UnknownState",
+			body: gin.H{"state": "stopped"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					TransitionServiceDetailTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, codeInvalidRequest)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/service-orders/%d/services/%d/transitions", orderID, serviceID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, util.RandomName(), util.RoleMechanic, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,432 @@
+package api
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"time"
+
+	db "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/sqlc"
+	"github.com/gin-gonic/gin"
+)
+
+// swagger:model ServiceOrderResponse
+type ServiceOrderResponse struct {
+	// The ID of a Service Order
+	// example: 1
+	ID int32 `json:"id"`
+	// The ID of the Car being serviced
+	// example: 1
+	CarID int32 `json:"car_id"`
+	// What the customer asked for
+	// example: brakes are noisy
+	Description string `json:"description"`
+	// The day the Car was received
+	// example: 2022-06-01T00:00:00Z
+	DateReceived *time.Time `json:"date_received"`
+	// The day the Car was returned to the Customer
+	// example: 2022-06-03T00:00:00Z
+	DateReturned *time.Time `json:"date_returned"`
+	// The state of the Service Order
+	// example: in_progress
+	State string `json:"state"`
+	// The version of the Service Order, bumped by every update
+	// example: 1
+	Version int32 `json:"version"`
+	// The odometer reading of the Car when it was received, in kilometers
+	// example: 84500
+	Mileage *int32 `json:"mileage"`
+}
+
+func newServiceOrderResponse(order db.ServiceOrder) ServiceOrderResponse {
+	return ServiceOrderResponse{
+		ID:           order.ID,
+		CarID:        order.CarID,
+		Description:  order.Description.String,
+		DateReceived: nullTime(order.DateReceived),
+		DateReturned: nullTime(order.DateReturned),
+		State:        db.ServiceOrderStateName(order.State),
+		Version:      order.Version,
+		Mileage:      nullInt32(order.Mileage),
+	}
+}
+
+func nullInt32(i sql.NullInt32) *int32 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Int32
+}
+
+func nullTime(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+// swagger:model createServiceOrderRequest
+type createServiceOrderRequest struct {
+	// The ID of the Car being serviced
+	// example: 1
+	CarID int32 `json:"carId" binding:"required,min=1"`
+	// What the customer asked for
+	// example: brakes are noisy
+	Description string `json:"description"`
+	// The odometer reading of the Car, in kilometers
+	// example: 84500
+	Mileage *int32 `json:"mileage" binding:"omitempty,min=0"`
+}
+
+// createServiceOrder godoc
+// @Summary Create new Service Order
+// @Description Open a new Service Order for a Car, the Car is received today
+// @ID create-ServiceOrder
+// @Tags ServiceOrder
+// @Accept  json
+// @Produce  json
+// @Param Body body createServiceOrderRequest true "The body to create a Service Order"
+// @Success 200 {object} ServiceOrderResponse
+// @Header 200 {string} ETag "The version of the Service Order, to send in If-Match"
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /service-orders [post]
+func (server *Server) createServiceOrder(ctx *gin.Context) {
+	var req createServiceOrderRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	arg := db.CreateServiceOrderParams{
+		CarID:       req.CarID,
+		Description: sql.NullString{String: req.Description, Valid: req.Description != ""},
+		State:       db.ServiceOrderOpen,
+	}
+	if req.Mileage != nil {
+		arg.Mileage = sql.NullInt32{Int32: *req.Mileage, Valid: true}
+	}
+	order, err := server.store.CreateServiceOrder(ctx, arg)
+	if err == sql.ErrNoRows {
+		// the order is only inserted for a car that is not deleted
+		err := fmt.Errorf("car %d does not exist", req.CarID)
+		ctx.JSON(http.StatusUnprocessableEntity, errorResponse(codeForeignKeyViolation, err))
+		return
+	}
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	setETag(ctx, order.Version)
+	ctx.JSON(http.StatusOK, newServiceOrderResponse(order))
+}
+
+type getServiceOrderRequest struct {
+	ID int32 `uri:"id" binding:"required,min=1"`
+}
+
+// getServiceOrder godoc
+// @Summary  GET Service Order
+// @Description  GET  Service Order by it's id
+// @Tags ServiceOrder
+// @ID get-ServiceOrder
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id to get a Service Order"
+// @Success 200 {object} ServiceOrderResponse
+// @Header 200 {string} ETag "The version of the Service Order, to send in If-Match"
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /service-orders/{id} [get]
+func (server *Server) getServiceOrder(ctx *gin.Context) {
+	var req getServiceOrderRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	order, err := server.store.GetServiceOrder(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	setETag(ctx, order.Version)
+	ctx.JSON(http.StatusOK, newServiceOrderResponse(order))
+}
+
+// swagger:model ListServiceOrdersRequest
+type ListServiceOrdersRequest struct {
+	pageRequest
+}
+
+// listServiceOrders godoc
+// @Summary list all Service Orders
+// @Description GET list of all Service Orders
+// @Tags ServiceOrder
+// @ID list-ServiceOrder
+// @Accept  json
+// @Produce  json
+// @Param cursor query string false "The next_cursor of the previous page, none for the first page"
+// @Param page_size query int false "The number of Service Orders per page, 20 by default"
+// @Param sort query string false "The field to sort by, descending when prefixed with a -: car_id, state or id (default)"
+// @Param car_id query int false "Only the Service Orders of this Car"
+// @Param state query string false "Only the Service Orders in this state" Enums(open, diagnosis, awaiting_parts, in_progress, ready, returned, cancelled)
+// @Param received_after query string false "Only the Service Orders received on or after this date or RFC 3339 time"
+// @Param received_before query string false "Only the Service Orders received before this date or RFC 3339 time"
+// @Success 200 {object} PageResponse{items=[]ServiceOrderResponse}
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /service-orders [get]
+func (server *Server) listServiceOrders(ctx *gin.Context) {
+	var req ListServiceOrdersRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+	query, err := serviceOrderListSpec.parse(ctx.Request.URL.Query(), req.pageRequest)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	orders, err := server.store.ListServiceOrdersWhere(ctx, query.params)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+	totalCount, err := server.store.CountServiceOrdersWhere(ctx, query.params.Where)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+	orders, nextCursor := serviceOrderListSpec.nextPage(orders, query)
+
+	rsp := make([]ServiceOrderResponse, 0, len(orders))
+	for _, order := range orders {
+		rsp = append(rsp, newServiceOrderResponse(order))
+	}
+	ctx.JSON(http.StatusOK, PageResponse{Items: rsp, NextCursor: nextCursor, TotalCount: totalCount})
+}
+
+// swagger:model updateServiceOrderRequest
+type updateServiceOrderRequest struct {
+	// What the customer asked for
+	// example: brakes are noisy
+	Description string `json:"description"`
+	// The odometer reading of the Car, in kilometers, removed when omitted
+	// example: 84500
+	Mileage *int32 `json:"mileage" binding:"omitempty,min=0"`
+}
+
+// updateServiceOrder godoc
+// @Summary update  Service Order
+// @Description replace the description and the mileage of a Service Order, use PATCH to change one of them and the transitions api to change its state
+// @Tags ServiceOrder
+// @ID update-ServiceOrder
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id to update a Service Order"
+// @Param Body body updateServiceOrderRequest true "The body to update a Service Order"
+// @Param If-Match header string false "The ETag of the version to change, the request fails with a 412 when the Service Order changed since"
+// @Success 200 {object} ServiceOrderResponse
+// @Header 200 {string} ETag "The version of the Service Order, to send in If-Match"
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /service-orders/{id} [put]
+func (server *Server) updateServiceOrder(ctx *gin.Context) {
+	var uri getServiceOrderRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	var req updateServiceOrderRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	cond, ok := bindIfMatch(ctx)
+	if !ok {
+		return
+	}
+
+	arg := db.UpdateServiceOrderParams{
+		ID:          uri.ID,
+		Description: sql.NullString{String: req.Description, Valid: req.Description != ""},
+		Versions:    cond.versions,
+	}
+	if req.Mileage != nil {
+		arg.Mileage = sql.NullInt32{Int32: *req.Mileage, Valid: true}
+	}
+	order, err := server.store.UpdateServiceOrder(ctx, arg)
+	if err != nil {
+		ctx.JSON(cond.errorResponse(err))
+		return
+	}
+
+	setETag(ctx, order.Version)
+	ctx.JSON(http.StatusOK, newServiceOrderResponse(order))
+}
+
+// swagger:model patchServiceOrderRequest
+type patchServiceOrderRequest struct {
+	// What the customer asked for, unchanged when omitted
+	// example: brakes are noisy
+	Description *string `json:"description" binding:"omitempty,min=1"`
+	// The odometer reading of the Car, in kilometers, unchanged when omitted
+	// example: 84500
+	Mileage *int32 `json:"mileage" binding:"omitempty,min=0"`
+}
+
+// patchServiceOrder godoc
+// @Summary patch  Service Order
+// @Description change the description or the mileage of a Service Order with a JSON merge patch (RFC 7396): the fields omitted are left unchanged, none can be removed with null
+// @Tags ServiceOrder
+// @ID patch-ServiceOrder
+// @Accept  json
+// @Accept  application/merge-patch+json
+// @Produce  json
+// @Param id path string true  "The id to patch a Service Order"
+// @Param Body body patchServiceOrderRequest true "The fields to change"
+// @Param If-Match header string false "The ETag of the version to change, the request fails with a 412 when the Service Order changed since"
+// @Success 200 {object} ServiceOrderResponse
+// @Header 200 {string} ETag "The version of the Service Order, to send in If-Match"
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /service-orders/{id} [patch]
+func (server *Server) patchServiceOrder(ctx *gin.Context) {
+	var uri getServiceOrderRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	var req patchServiceOrderRequest
+	if err := bindMergePatch(ctx, &req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	cond, ok := bindIfMatch(ctx)
+	if !ok {
+		return
+	}
+
+	arg := db.PatchServiceOrderParams{ID: uri.ID, Versions: cond.versions}
+	if req.Description != nil {
+		arg.Description = sql.NullString{String: *req.Description, Valid: true}
+	}
+	if req.Mileage != nil {
+		arg.Mileage = sql.NullInt32{Int32: *req.Mileage, Valid: true}
+	}
+
+	order, err := server.store.PatchServiceOrder(ctx, arg)
+	if err != nil {
+		ctx.JSON(cond.errorResponse(err))
+		return
+	}
+
+	setETag(ctx, order.Version)
+	ctx.JSON(http.StatusOK, newServiceOrderResponse(order))
+}
+
+// deleteServiceOrder godoc
+// @Summary DELETE a Service Order
+// @Description use this api to delete a service order by it's id, an invoiced service order cannot be deleted
+// @Tags ServiceOrder
+// @ID delete-ServiceOrder
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id to delete a Service Order"
+// @Param If-Match header string false "The ETag of the version to delete, the request fails with a 412 when the Service Order changed since"
+// @Success 204 string deleted
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /service-orders/{id} [delete]
+func (server *Server) deleteServiceOrder(ctx *gin.Context) {
+	var req getServiceOrderRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	cond, ok := bindIfMatch(ctx)
+	if !ok {
+		return
+	}
+
+	err := server.store.DeleteServiceOrderTx(ctx, db.DeleteServiceOrderParams{ID: req.ID, Versions: cond.versions})
+	if err != nil {
+		ctx.JSON(cond.errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, "deleted")
+}
+
+// swagger:model transitionServiceOrderRequest
+type transitionServiceOrderRequest struct {
+	// The state to move the Service Order to
+	// example: diagnosis
+	State string `json:"state" binding:"required,oneof=open diagnosis awaiting_parts in_progress ready returned cancelled"`
+}
+
+// transitionServiceOrder godoc
+// @Summary move a Service Order to another state
+// @Description open -> diagnosis -> (awaiting_parts <->) in_progress -> ready -> returned, any unfinished order can be cancelled.
+// @Description An invoiced order can only be returned. The return date is set when the order is returned.
+// @Tags ServiceOrder
+// @ID transition-ServiceOrder
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id of the Service Order"
+// @Param Body body transitionServiceOrderRequest true "The state to move to"
+// @Success 200 {object} ServiceOrderResponse
+// @Header 200 {string} ETag "The version of the Service Order, to send in If-Match"
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /service-orders/{id}/transitions [post]
+// @Router /service-orders/{id}/transitions [put]
+func (server *Server) transitionServiceOrder(ctx *gin.Context) {
+	var uri getServiceOrderRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	var req transitionServiceOrderRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+	to, _ := db.ParseServiceOrderState(req.State)
+
+	order, err := server.store.TransitionServiceOrderTx(ctx, db.TransitionServiceOrderTxParams{ID: uri.ID, State: to})
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	setETag(ctx, order.Version)
+	ctx.JSON(http.StatusOK, newServiceOrderResponse(order))
+}
@@ -0,0 +1,274 @@
+package api
+
+import (
+	"database/sql"
+	"net/http"
+
+	db "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/sqlc"
+	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/util"
+	"github.com/gin-gonic/gin"
+)
+
+// swagger:model SupplierResponse
+type SupplierResponse struct {
+	// The ID of a Supplier
+	// example: 1
+	ID int32 `json:"id"`
+	// The Name of a Supplier
+	// example: Auto Parts Algiers
+	Name string `json:"name"`
+	// The Address of a Supplier
+	// example: 12 rue Didouche Mourad, Algiers
+	Address string `json:"address"`
+	// The PhoneNumber of a Supplier
+	// example: +2131122334455
+	PhoneNumber string `json:"phone_number"`
+}
+
+func newSupplierResponse(supplier db.Supplier) SupplierResponse {
+	return SupplierResponse{
+		ID:          supplier.ID,
+		Name:        supplier.Name,
+		Address:     supplier.Address.String,
+		PhoneNumber: supplier.PhoneNumber,
+	}
+}
+
+// swagger:model createSupplierRequest
+type createSupplierRequest struct {
+	// The Name of a Supplier
+	// example: Auto Parts Algiers
+	Name string `json:"name" binding:"required"`
+	// The Address of a Supplier
+	// example: 12 rue Didouche Mourad, Algiers
+	Address string `json:"address"`
+	// The PhoneNumber of a Supplier, normalized to E.164
+	// example: +213550123456
+	PhoneNumber string `json:"phoneNumber" binding:"required,phone"`
+}
+
+// createSupplier godoc
+// @Summary Create new Supplier
+// @Description Create a new Supplier
+// @ID create-Supplier
+// @Tags Supplier
+// @Accept  json
+// @Produce  json
+// @Param Body body createSupplierRequest true "The body to create a Supplier"
+// @Success 200 {object} SupplierResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /suppliers [post]
+func (server *Server) createSupplier(ctx *gin.Context) {
+	var req createSupplierRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	phoneNumber, err := util.NormalizePhone(req.PhoneNumber, server.config.DefaultPhoneRegion)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	arg := db.CreateSupplierParams{
+		Name:        req.Name,
+		Address:     sql.NullString{String: req.Address, Valid: req.Address != ""},
+		PhoneNumber: phoneNumber,
+	}
+	supplier, err := server.store.CreateSupplier(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newSupplierResponse(supplier))
+}
+
+type getSupplierRequest struct {
+	ID int32 `uri:"id" binding:"required,min=1"`
+}
+
+// getSupplier godoc
+// @Summary  GET Supplier
+// @Description  GET  Supplier by it's id
+// @Tags Supplier
+// @ID get-Supplier
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id to get a Supplier"
+// @Success 200 {object} SupplierResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /suppliers/{id} [get]
+func (server *Server) getSupplier(ctx *gin.Context) {
+	var req getSupplierRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	supplier, err := server.store.GetSupplier(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newSupplierResponse(supplier))
+}
+
+// swagger:model ListSuppliersRequest
+type ListSuppliersRequest struct {
+	pageRequest
+}
+
+// listSuppliers godoc
+// @Summary list all Suppliers
+// @Description GET list of all Suppliers
+// @Tags Supplier
+// @ID list-Supplier
+// @Accept  json
+// @Produce  json
+// @Param cursor query string false "The next_cursor of the previous page, none for the first page"
+// @Param page_size query int false "The number of Suppliers per page, 20 by default"
+// @Success 200 {object} PageResponse{items=[]SupplierResponse}
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /suppliers [get]
+func (server *Server) listSuppliers(ctx *gin.Context) {
+	var req ListSuppliersRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+	cursor, err := decodeCursor(req.Cursor)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	arg := db.ListSuppliersParams{
+		AfterID: int32(cursor.AfterID),
+		Limit:   req.size() + 1,
+	}
+	suppliers, err := server.store.ListSuppliers(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+	totalCount, err := server.store.CountSuppliers(ctx)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+	suppliers, nextCursor := nextPage(suppliers, req.size(), func(supplier db.Supplier) pageCursor {
+		return pageCursor{AfterID: int64(supplier.ID)}
+	})
+
+	rsp := make([]SupplierResponse, 0, len(suppliers))
+	for _, supplier := range suppliers {
+		rsp = append(rsp, newSupplierResponse(supplier))
+	}
+	ctx.JSON(http.StatusOK, PageResponse{Items: rsp, NextCursor: nextCursor, TotalCount: totalCount})
+}
+
+// swagger:model updateSupplierRequest
+type updateSupplierRequest struct {
+	// The Name of a Supplier
+	// example: Auto Parts Algiers
+	Name string `json:"name" binding:"required"`
+	// The Address of a Supplier
+	// example: 12 rue Didouche Mourad, Algiers
+	Address string `json:"address"`
+	// The PhoneNumber of a Supplier, normalized to E.164
+	// example: +213550123456
+	PhoneNumber string `json:"phoneNumber" binding:"required,phone"`
+}
+
+// updateSupplier godoc
+// @Summary update  Supplier
+// @Description update a  Supplier
+// @Tags Supplier
+// @ID update-Supplier
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id to update a Supplier"
+// @Param Body body updateSupplierRequest true "The body to update a Supplier"
+// @Success 200 {object} SupplierResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /suppliers/{id} [put]
+func (server *Server) updateSupplier(ctx *gin.Context) {
+	var uri getSupplierRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	var req updateSupplierRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	phoneNumber, err := util.NormalizePhone(req.PhoneNumber, server.config.DefaultPhoneRegion)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	arg := db.UpdateSupplierParams{
+		ID:          uri.ID,
+		Name:        req.Name,
+		Address:     sql.NullString{String: req.Address, Valid: req.Address != ""},
+		PhoneNumber: phoneNumber,
+	}
+	supplier, err := server.store.UpdateSupplier(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newSupplierResponse(supplier))
+}
+
+// deleteSupplier godoc
+// @Summary DELETE a Supplier
+// @Description use this api to delete a supplier by it's id, a supplier with purchase invoices cannot be deleted
+// @Tags Supplier
+// @ID delete-Supplier
+// @Accept  json
+// @Produce  json
+// @Param id path string true  "The id to delete a Supplier"
+// @Success 204 string deleted
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /suppliers/{id} [delete]
+func (server *Server) deleteSupplier(ctx *gin.Context) {
+	var req getSupplierRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	rows, err := server.store.DeleteSupplier(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+	if rows == 0 {
+		ctx.JSON(http.StatusNotFound, errorResponse(codeNotFound, sql.ErrNoRows))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, "deleted")
+}
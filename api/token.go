@@ -0,0 +1,111 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	db "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/sqlc"
+	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/token"
+	"github.com/gin-gonic/gin"
+)
+
+// swagger:model renewAccessTokenRequest
+type renewAccessTokenRequest struct {
+	// The refresh token issued at login
+	RefreshToken string `json:"refreshToken" binding:"required"`
+}
+
+// swagger:model RenewAccessTokenResponse
+type RenewAccessTokenResponse struct {
+	// The token to send in the Authorization header as "Bearer <token>"
+	AccessToken string `json:"access_token"`
+	// The time the access token expires
+	// example: 2022-06-03T10:15:00Z
+	AccessTokenExpiresAt time.Time `json:"access_token_expires_at"`
+}
+
+// renewAccessToken godoc
+// @Summary renew an access token
+// @Description Issue a new access token from the refresh token of a session that is not logged out or expired
+// @ID renew-access-token
+// @Tags User
+// @Accept  json
+// @Produce  json
+// @Param Body body renewAccessTokenRequest true "The refresh token"
+// @Success 200 {object} RenewAccessTokenResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /tokens/renew_access [post]
+func (server *Server) renewAccessToken(ctx *gin.Context) {
+	var req renewAccessTokenRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	refreshPayload, err := server.tokenMaker.VerifyToken(req.RefreshToken, token.KindRefresh)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(codeUnauthorized, err))
+		return
+	}
+
+	session, err := server.store.GetSession(ctx, refreshPayload.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusUnauthorized, errorResponse(codeUnauthorized, errUnknownSession))
+			return
+		}
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	if err := checkSession(session, refreshPayload, req.RefreshToken); err != nil {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(codeUnauthorized, err))
+		return
+	}
+
+	accessToken, accessPayload, err := server.tokenMaker.CreateToken(
+		refreshPayload.Username,
+		refreshPayload.Role,
+		token.KindAccess,
+		server.config.AccessTokenDuration,
+	)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(codeInternal, err))
+		return
+	}
+
+	rsp := RenewAccessTokenResponse{
+		AccessToken:          accessToken,
+		AccessTokenExpiresAt: accessPayload.ExpiredAt,
+	}
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+// The reasons a refresh token is refused once its session is found
+var (
+	errUnknownSession = errors.New("session not found")
+	errBlockedSession = errors.New("session is blocked")
+	errSessionUser    = errors.New("session belongs to another user")
+	errSessionToken   = errors.New("refresh token does not match the session")
+	errExpiredSession = errors.New("session has expired")
+)
+
+// checkSession checks that a refresh token is the one stored in its session, and that the
+// session was not blocked by a logout and has not expired
+func checkSession(session db.Session, payload *token.Payload, refreshToken string) error {
+	switch {
+	case session.IsBlocked:
+		return errBlockedSession
+	case session.Username != payload.Username:
+		return errSessionUser
+	case session.RefreshToken != refreshToken:
+		return errSessionToken
+	case time.Now().After(session.ExpiresAt):
+		return errExpiredSession
+	}
+	return nil
+}
@@ -0,0 +1,379 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	db "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/sqlc"
+	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/token"
+	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/util"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// swagger:model UserResponse
+type UserResponse struct {
+	// The login of a User
+	// example: karim
+	Username string `json:"username"`
+	// The Name of a User
+	// example: Karim Stam
+	FullName string `json:"full_name"`
+	// The role of a User: admin, front_desk, mechanic or accountant
+	// example: front_desk
+	Role string `json:"role"`
+	// The last time the password of a User was changed
+	// example: 2022-06-03T10:00:00Z
+	PasswordChangedAt time.Time `json:"password_changed_at"`
+	// The time a User was created
+	// example: 2022-06-01T08:00:00Z
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func newUserResponse(user db.User) UserResponse {
+	return UserResponse{
+		Username:          user.Username,
+		FullName:          user.FullName,
+		Role:              user.Role,
+		PasswordChangedAt: user.PasswordChangedAt,
+		CreatedAt:         user.CreatedAt,
+	}
+}
+
+// swagger:model createUserRequest
+type createUserRequest struct {
+	// The login of a User
+	// example: karim
+	Username string `json:"username" binding:"required,alphanum"`
+	// The password of a User
+	// example: secret123
+	Password string `json:"password" binding:"required,min=6"`
+	// The Name of a User
+	// example: Karim Stam
+	FullName string `json:"fullName" binding:"required"`
+	// The role of a User: admin, front_desk, mechanic or accountant
+	// example: front_desk
+	Role string `json:"role" binding:"required,oneof=admin front_desk mechanic accountant"`
+}
+
+// createUser godoc
+// @Summary Create new User
+// @Description Create a new staff User, only admins can create Users
+// @ID create-User
+// @Tags User
+// @Accept  json
+// @Produce  json
+// @Param Body body createUserRequest true "The body to create a User"
+// @Success 200 {object} UserResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /users [post]
+func (server *Server) createUser(ctx *gin.Context) {
+	var req createUserRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	hashedPassword, err := util.HashPassword(req.Password)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(codeInternal, err))
+		return
+	}
+
+	arg := db.CreateUserParams{
+		Username:       req.Username,
+		HashedPassword: hashedPassword,
+		FullName:       req.FullName,
+		Role:           req.Role,
+	}
+	user, err := server.store.CreateUser(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newUserResponse(user))
+}
+
+// swagger:model setupUserRequest
+type setupUserRequest struct {
+	// The login of the first admin
+	// example: admin
+	Username string `json:"username" binding:"required,alphanum"`
+	// The password of the first admin
+	// example: secret123
+	Password string `json:"password" binding:"required,min=6"`
+	// The Name of the first admin
+	// example: Karim Stam
+	FullName string `json:"fullName" binding:"required"`
+}
+
+// setupFirstAdmin godoc
+// @Summary Create the first admin
+// @Description Create the first admin User of a new installation, refused once any User exists
+// @ID setup-User
+// @Tags User
+// @Accept  json
+// @Produce  json
+// @Param Body body setupUserRequest true "The first admin"
+// @Success 200 {object} UserResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/setup [post]
+func (server *Server) setupFirstAdmin(ctx *gin.Context) {
+	var req setupUserRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	hashedPassword, err := util.HashPassword(req.Password)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(codeInternal, err))
+		return
+	}
+
+	arg := db.CreateFirstAdminParams{
+		Username:       req.Username,
+		HashedPassword: hashedPassword,
+		FullName:       req.FullName,
+	}
+	user, err := server.store.CreateFirstAdminTx(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newUserResponse(user))
+}
+
+// swagger:model loginUserRequest
+type loginUserRequest struct {
+	// The login of a User
+	// example: karim
+	Username string `json:"username" binding:"required,alphanum"`
+	// The password of a User
+	// example: secret123
+	Password string `json:"password" binding:"required,min=6"`
+}
+
+// swagger:model LoginUserResponse
+type LoginUserResponse struct {
+	// The session opened by the login
+	// example: 7c9e6679-7425-40de-944b-e07fc1f90ae7
+	SessionID uuid.UUID `json:"session_id" swaggertype:"string" format:"uuid"`
+	// The token to send in the Authorization header as "Bearer <token>"
+	AccessToken string `json:"access_token"`
+	// The time the access token expires
+	// example: 2022-06-03T10:15:00Z
+	AccessTokenExpiresAt time.Time `json:"access_token_expires_at"`
+	// The token to renew the access token with, until the session is logged out
+	RefreshToken string `json:"refresh_token"`
+	// The time the refresh token expires
+	// example: 2022-06-04T10:00:00Z
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at"`
+	// The User logged in
+	User UserResponse `json:"user"`
+}
+
+// loginUser godoc
+// @Summary log a User in
+// @Description Check the password of a User, open a session and issue an access and a refresh token
+// @ID login-User
+// @Tags User
+// @Accept  json
+// @Produce  json
+// @Param Body body loginUserRequest true "The credentials of a User"
+// @Success 200 {object} LoginUserResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/login [post]
+func (server *Server) loginUser(ctx *gin.Context) {
+	var req loginUserRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	// an unknown user and a wrong password get the same answer
+	errInvalidCredentials := errors.New("invalid username or password")
+
+	user, err := server.store.GetUser(ctx, req.Username)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusUnauthorized, errorResponse(codeUnauthorized, errInvalidCredentials))
+			return
+		}
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	err = util.CheckPassword(req.Password, user.HashedPassword)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(codeUnauthorized, errInvalidCredentials))
+		return
+	}
+
+	accessToken, accessPayload, err := server.tokenMaker.CreateToken(
+		user.Username,
+		user.Role,
+		token.KindAccess,
+		server.config.AccessTokenDuration,
+	)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(codeInternal, err))
+		return
+	}
+
+	refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(
+		user.Username,
+		user.Role,
+		token.KindRefresh,
+		server.config.RefreshTokenDuration,
+	)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(codeInternal, err))
+		return
+	}
+
+	session, err := server.store.CreateSession(ctx, db.CreateSessionParams{
+		ID:           refreshPayload.ID,
+		Username:     user.Username,
+		RefreshToken: refreshToken,
+		UserAgent:    ctx.Request.UserAgent(),
+		ClientIp:     ctx.ClientIP(),
+		IsBlocked:    false,
+		ExpiresAt:    refreshPayload.ExpiredAt,
+	})
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+
+	rsp := LoginUserResponse{
+		SessionID:             session.ID,
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessPayload.ExpiredAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: refreshPayload.ExpiredAt,
+		User:                  newUserResponse(user),
+	}
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+// swagger:model logoutUserRequest
+type logoutUserRequest struct {
+	// The refresh token of the session to close
+	RefreshToken string `json:"refreshToken" binding:"required"`
+}
+
+// logoutUser godoc
+// @Summary log a User out
+// @Description Block the session of a refresh token so it can no longer renew access tokens, the access tokens already issued stay valid until they expire
+// @ID logout-User
+// @Tags User
+// @Accept  json
+// @Produce  json
+// @Param Body body logoutUserRequest true "The refresh token of the session"
+// @Success 204 string logged out
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/logout [post]
+func (server *Server) logoutUser(ctx *gin.Context) {
+	var req logoutUserRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	refreshPayload, err := server.tokenMaker.VerifyToken(req.RefreshToken, token.KindRefresh)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(codeUnauthorized, err))
+		return
+	}
+
+	arg := db.BlockSessionParams{
+		ID:       refreshPayload.ID,
+		Username: refreshPayload.Username,
+	}
+	rows, err := server.store.BlockSession(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+	if rows == 0 {
+		ctx.JSON(http.StatusNotFound, errorResponse(codeNotFound, errUnknownSession))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, "logged out")
+}
+
+// swagger:model ListUsersRequest
+type ListUsersRequest struct {
+	pageRequest
+}
+
+// listUsers godoc
+// @Summary list all Users
+// @Description GET list of all staff Users, only admins can list Users
+// @Tags User
+// @ID list-User
+// @Accept  json
+// @Produce  json
+// @Param cursor query string false "The next_cursor of the previous page, none for the first page"
+// @Param page_size query int false "The number of Users per page, 20 by default"
+// @Success 200 {object} PageResponse{items=[]UserResponse}
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /users [get]
+func (server *Server) listUsers(ctx *gin.Context) {
+	var req ListUsersRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+	cursor, err := decodeCursor(req.Cursor)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(codeInvalidRequest, err))
+		return
+	}
+
+	arg := db.ListUsersParams{
+		AfterUsername: cursor.AfterKey,
+		Limit:         req.size() + 1,
+	}
+	users, err := server.store.ListUsers(ctx, arg)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+	totalCount, err := server.store.CountUsers(ctx)
+	if err != nil {
+		ctx.JSON(dbErrorResponse(err))
+		return
+	}
+	users, nextCursor := nextPage(users, req.size(), func(user db.User) pageCursor {
+		return pageCursor{AfterKey: user.Username}
+	})
+
+	rsp := make([]UserResponse, 0, len(users))
+	for _, user := range users {
+		rsp = append(rsp, newUserResponse(user))
+	}
+	ctx.JSON(http.StatusOK, PageResponse{Items: rsp, NextCursor: nextCursor, TotalCount: totalCount})
+}
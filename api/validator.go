@@ -0,0 +1,18 @@
+package api
+
+import (
+	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/util"
+	"github.com/go-playground/validator/v10"
+)
+
+// phoneValidator accepts the phone numbers util.NormalizePhone can normalize, a number
+// without its country code is read as a number of the default region
+func phoneValidator(defaultRegion string) validator.Func {
+	return func(fieldLevel validator.FieldLevel) bool {
+		if phone, ok := fieldLevel.Field().Interface().(string); ok {
+			_, err := util.NormalizePhone(phone, defaultRegion)
+			return err == nil
+		}
+		return false
+	}
+}
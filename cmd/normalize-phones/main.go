@@ -0,0 +1,199 @@
+// Command normalize-phones formats the phone numbers of the existing customers and suppliers
+// to E.164, as the API does for the new ones since phone numbers are validated.
+//
+// Rows whose number cannot be parsed, and rows whose numbers become the same once normalized,
+// are reported and left unchanged so they can be fixed by hand. Run it from the root of the
+// repository so app.env is found, with -dry-run to only print the report:
+//
+//	go run ./cmd/normalize-phones -dry-run
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	_ "github.com/lib/pq"
+
+	db "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/sqlc"
+	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/util"
+)
+
+const pageSize = 100
+
+// phoneRow is a customer or a supplier with its phone number before and after normalization
+type phoneRow struct {
+	id         int64
+	name       string
+	phone      string
+	normalized string
+}
+
+func main() {
+	dryRun := flag.Bool("dry-run", false, "report the changes and collisions without updating any row")
+	flag.Parse()
+
+	config, err := util.LoadConfig(".")
+	if err != nil {
+		log.Fatal("cannot load config:", err)
+	}
+	if !util.IsSupportedPhoneRegion(config.DefaultPhoneRegion) {
+		log.Fatalf("unsupported default phone region %q", config.DefaultPhoneRegion)
+	}
+	conn, err := sql.Open(config.DBDriver, config.DBSource)
+	if err != nil {
+		log.Fatal("cannot connect to db:", err)
+	}
+	defer conn.Close()
+
+	ctx := context.Background()
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		log.Fatal("cannot begin transaction:", err)
+	}
+	defer tx.Rollback()
+	q := db.New(tx)
+
+	customers, err := listCustomerPhones(ctx, q)
+	if err != nil {
+		log.Fatal("cannot list customers:", err)
+	}
+	suppliers, err := listSupplierPhones(ctx, q)
+	if err != nil {
+		log.Fatal("cannot list suppliers:", err)
+	}
+
+	customerUpdates, customerProblems := planUpdates("customer", customers, config.DefaultPhoneRegion)
+	supplierUpdates, supplierProblems := planUpdates("supplier", suppliers, config.DefaultPhoneRegion)
+
+	for _, row := range customerUpdates {
+		fmt.Printf("customer %d: %q -> %q\n", row.id, row.phone, row.normalized)
+		if *dryRun {
+			continue
+		}
+		customer, err := q.GetCustomer(ctx, row.id)
+		if err != nil {
+			log.Fatalf("cannot get customer %d: %v", row.id, err)
+		}
+		_, err = q.UpdateCustomer(ctx, db.UpdateCustomerParams{
+			ID:          customer.ID,
+			FullName:    customer.FullName,
+			PhoneNumber: row.normalized,
+		})
+		if err != nil {
+			log.Fatalf("cannot update customer %d: %v", row.id, err)
+		}
+	}
+
+	for _, row := range supplierUpdates {
+		fmt.Printf("supplier %d: %q -> %q\n", row.id, row.phone, row.normalized)
+		if *dryRun {
+			continue
+		}
+		supplier, err := q.GetSupplier(ctx, int32(row.id))
+		if err != nil {
+			log.Fatalf("cannot get supplier %d: %v", row.id, err)
+		}
+		_, err = q.UpdateSupplier(ctx, db.UpdateSupplierParams{
+			ID:          supplier.ID,
+			Name:        supplier.Name,
+			Address:     supplier.Address,
+			PhoneNumber: row.normalized,
+		})
+		if err != nil {
+			log.Fatalf("cannot update supplier %d: %v", row.id, err)
+		}
+	}
+
+	problems := append(customerProblems, supplierProblems...)
+	for _, problem := range problems {
+		fmt.Println(problem)
+	}
+
+	if *dryRun {
+		fmt.Printf("dry run: %d customers and %d suppliers to update, %d problems\n",
+			len(customerUpdates), len(supplierUpdates), len(problems))
+		return
+	}
+	if err := tx.Commit(); err != nil {
+		log.Fatal("cannot commit:", err)
+	}
+	fmt.Printf("updated %d customers and %d suppliers, %d problems left unchanged\n",
+		len(customerUpdates), len(supplierUpdates), len(problems))
+}
+
+func listCustomerPhones(ctx context.Context, q *db.Queries) ([]phoneRow, error) {
+	var rows []phoneRow
+	for afterID := int64(0); ; {
+		customers, err := q.ListCustomers(ctx, db.ListCustomersParams{AfterID: afterID, Limit: pageSize})
+		if err != nil {
+			return nil, err
+		}
+		for _, customer := range customers {
+			rows = append(rows, phoneRow{id: customer.ID, name: customer.FullName, phone: customer.PhoneNumber})
+			afterID = customer.ID
+		}
+		if len(customers) < pageSize {
+			return rows, nil
+		}
+	}
+}
+
+func listSupplierPhones(ctx context.Context, q *db.Queries) ([]phoneRow, error) {
+	var rows []phoneRow
+	for afterID := int32(0); ; {
+		suppliers, err := q.ListSuppliers(ctx, db.ListSuppliersParams{AfterID: afterID, Limit: pageSize})
+		if err != nil {
+			return nil, err
+		}
+		for _, supplier := range suppliers {
+			rows = append(rows, phoneRow{id: int64(supplier.ID), name: supplier.Name, phone: supplier.PhoneNumber})
+			afterID = supplier.ID
+		}
+		if len(suppliers) < pageSize {
+			return rows, nil
+		}
+	}
+}
+
+// planUpdates normalizes the phone numbers of the rows of a table and returns the rows to
+// update, the numbers that cannot be parsed and the rows that would share a number are
+// returned as problems instead
+func planUpdates(table string, rows []phoneRow, defaultRegion string) (updates []phoneRow, problems []string) {
+	byNumber := make(map[string][]phoneRow)
+	for _, row := range rows {
+		normalized, err := util.NormalizePhone(row.phone, defaultRegion)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("invalid: %s %d (%s): %v", table, row.id, row.name, err))
+			continue
+		}
+		row.normalized = normalized
+		byNumber[normalized] = append(byNumber[normalized], row)
+	}
+
+	numbers := make([]string, 0, len(byNumber))
+	for number := range byNumber {
+		numbers = append(numbers, number)
+	}
+	sort.Strings(numbers)
+
+	for _, number := range numbers {
+		same := byNumber[number]
+		if len(same) > 1 {
+			names := make([]string, 0, len(same))
+			for _, row := range same {
+				names = append(names, fmt.Sprintf("%s %d (%s, %q)", table, row.id, row.name, row.phone))
+			}
+			problems = append(problems, fmt.Sprintf("collision on %s: %s", number, strings.Join(names, ", ")))
+			continue
+		}
+		if row := same[0]; row.normalized != row.phone {
+			updates = append(updates, row)
+		}
+	}
+	return updates, problems
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanUpdates(t *testing.T) {
+	rows := []phoneRow{
+		{id: 1, name: "already normalized", phone: "+213550123456"},
+		{id: 2, name: "national", phone: "0661 12 34 56"},
+		{id: 3, name: "same as 4", phone: "0770-11-22-33"},
+		{id: 4, name: "same as 3", phone: "+213 770 11 22 33"},
+		{id: 5, name: "invalid", phone: "123"},
+	}
+
+	updates, problems := planUpdates("customer", rows, "DZ")
+
+	require.Equal(t, []phoneRow{
+		{id: 2, name: "national", phone: "0661 12 34 56", normalized: "+213661123456"},
+	}, updates)
+
+	require.Len(t, problems, 2)
+	require.Contains(t, problems[0], "invalid: customer 5")
+	require.Contains(t, problems[1], "collision on +213770112233")
+	require.Contains(t, problems[1], "customer 3")
+	require.Contains(t, problems[1], "customer 4")
+}
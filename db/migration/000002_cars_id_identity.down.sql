@@ -0,0 +1 @@
+ALTER TABLE CARS ALTER COLUMN ID DROP IDENTITY IF EXISTS;
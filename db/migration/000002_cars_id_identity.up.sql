@@ -0,0 +1,3 @@
+-- the identity starts after the ids already assigned by hand
+ALTER TABLE CARS ALTER COLUMN ID ADD GENERATED BY DEFAULT AS IDENTITY;
+SELECT setval(pg_get_serial_sequence('cars', 'id'), COALESCE(max(id), 0) + 1, false) FROM cars;
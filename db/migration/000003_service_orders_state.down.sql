@@ -0,0 +1,4 @@
+ALTER TABLE SERVICE_ORDERS DROP CONSTRAINT IF EXISTS SERVICE_ORDERS_STATE_CHECK;
+ALTER TABLE SERVICE_ORDERS ALTER COLUMN STATE DROP DEFAULT;
+
+ALTER TABLE SERVICE_ORDERS ALTER COLUMN ID DROP IDENTITY IF EXISTS;
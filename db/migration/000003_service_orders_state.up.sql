@@ -0,0 +1,6 @@
+ALTER TABLE SERVICE_ORDERS ALTER COLUMN ID ADD GENERATED BY DEFAULT AS IDENTITY;
+SELECT setval(pg_get_serial_sequence('service_orders', 'id'), COALESCE(max(id), 0) + 1, false) FROM service_orders;
+
+-- 1 open, 2 diagnosis, 3 awaiting parts, 4 in progress, 5 ready, 6 returned, 7 cancelled
+ALTER TABLE SERVICE_ORDERS ALTER COLUMN STATE SET DEFAULT 1;
+ALTER TABLE SERVICE_ORDERS ADD CONSTRAINT SERVICE_ORDERS_STATE_CHECK CHECK (STATE BETWEEN 1 AND 7);
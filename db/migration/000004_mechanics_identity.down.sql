@@ -0,0 +1,2 @@
+ALTER TABLE MECHANIC_DETAILS ALTER COLUMN ID DROP IDENTITY IF EXISTS;
+ALTER TABLE MECHANICS ALTER COLUMN ID DROP IDENTITY IF EXISTS;
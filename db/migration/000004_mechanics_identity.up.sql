@@ -0,0 +1,4 @@
+ALTER TABLE MECHANICS ALTER COLUMN ID ADD GENERATED BY DEFAULT AS IDENTITY;
+SELECT setval(pg_get_serial_sequence('mechanics', 'id'), COALESCE(max(id), 0) + 1, false) FROM mechanics;
+ALTER TABLE MECHANIC_DETAILS ALTER COLUMN ID ADD GENERATED BY DEFAULT AS IDENTITY;
+SELECT setval(pg_get_serial_sequence('mechanic_details', 'id'), COALESCE(max(id), 0) + 1, false) FROM mechanic_details;
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS PURCHASE_DETAILS_PART_ID_IDX;
+DROP VIEW IF EXISTS PART_STOCK;
+
+ALTER TABLE PARTS DROP COLUMN IF EXISTS REORDER_LEVEL;
+
+ALTER TABLE PART_DETAILS ALTER COLUMN ID DROP IDENTITY IF EXISTS;
+ALTER TABLE PARTS ALTER COLUMN ID DROP IDENTITY IF EXISTS;
@@ -0,0 +1,22 @@
+ALTER TABLE PARTS ALTER COLUMN ID ADD GENERATED BY DEFAULT AS IDENTITY;
+SELECT setval(pg_get_serial_sequence('parts', 'id'), COALESCE(max(id), 0) + 1, false) FROM parts;
+ALTER TABLE PART_DETAILS ALTER COLUMN ID ADD GENERATED BY DEFAULT AS IDENTITY;
+SELECT setval(pg_get_serial_sequence('part_details', 'id'), COALESCE(max(id), 0) + 1, false) FROM part_details;
+
+ALTER TABLE PARTS ADD COLUMN REORDER_LEVEL INT NOT NULL DEFAULT 0 CHECK (REORDER_LEVEL >= 0);
+
+-- stock on hand is what was purchased minus what was used on service orders
+CREATE VIEW PART_STOCK AS
+SELECT
+    p.ID AS PART_ID,
+    p.NAME,
+    p.REORDER_LEVEL,
+    (SELECT COALESCE(SUM(pd.QUANTITY), 0) FROM PURCHASE_DETAILS pd WHERE pd.PART_ID = p.ID)::bigint AS PURCHASED,
+    (SELECT COALESCE(SUM(d.QUANTITY), 0) FROM PART_DETAILS d WHERE d.PART_ID = p.ID)::bigint AS CONSUMED,
+    (
+        (SELECT COALESCE(SUM(pd.QUANTITY), 0) FROM PURCHASE_DETAILS pd WHERE pd.PART_ID = p.ID) -
+        (SELECT COALESCE(SUM(d.QUANTITY), 0) FROM PART_DETAILS d WHERE d.PART_ID = p.ID)
+    )::bigint AS ON_HAND
+FROM PARTS p;
+
+CREATE INDEX PURCHASE_DETAILS_PART_ID_IDX ON PURCHASE_DETAILS(PART_ID);
@@ -0,0 +1,28 @@
+ALTER TABLE PURCHASE_INVOICES ALTER COLUMN TOTAL DROP NOT NULL;
+
+CREATE OR REPLACE FUNCTION set_purchase_invoice_total()
+   RETURNS TRIGGER
+   LANGUAGE PLPGSQL
+AS
+$$
+DECLARE
+   vartotal DECIMAL;
+BEGIN
+   SELECT TOTAL INTO vartotal FROM PURCHASE_INVOICES as p  WHERE p.id=NEW.PURCHASE_INVOICE_ID;
+   vartotal = vartotal + (NEW.PRICE * NEW.QUANTITY);
+   UPDATE PURCHASE_INVOICES as p SET TOTAL = vartotal WHERE p.id=NEW.PURCHASE_INVOICE_ID;
+    return NEW;
+END;
+$$;
+DROP TRIGGER IF EXISTS set_purchase_invoice_total_trigger on PURCHASE_DETAILS;
+CREATE TRIGGER set_purchase_invoice_total_trigger
+    AFTER INSERT
+    ON PURCHASE_DETAILS
+    FOR EACH ROW
+EXECUTE PROCEDURE set_purchase_invoice_total();
+
+ALTER TABLE PURCHASE_DETAILS DROP CONSTRAINT IF EXISTS PURCHASE_DETAILS_QUANTITY_CHECK;
+ALTER TABLE PURCHASE_DETAILS DROP CONSTRAINT IF EXISTS PURCHASE_DETAILS_PKEY;
+ALTER TABLE PURCHASE_DETAILS ALTER COLUMN ID DROP IDENTITY IF EXISTS;
+ALTER TABLE PURCHASE_INVOICES ALTER COLUMN ID DROP IDENTITY IF EXISTS;
+ALTER TABLE SUPPLIERS ALTER COLUMN ID DROP IDENTITY IF EXISTS;
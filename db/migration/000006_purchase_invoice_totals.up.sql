@@ -0,0 +1,41 @@
+ALTER TABLE SUPPLIERS ALTER COLUMN ID ADD GENERATED BY DEFAULT AS IDENTITY;
+SELECT setval(pg_get_serial_sequence('suppliers', 'id'), COALESCE(max(id), 0) + 1, false) FROM suppliers;
+ALTER TABLE PURCHASE_INVOICES ALTER COLUMN ID ADD GENERATED BY DEFAULT AS IDENTITY;
+SELECT setval(pg_get_serial_sequence('purchase_invoices', 'id'), COALESCE(max(id), 0) + 1, false) FROM purchase_invoices;
+ALTER TABLE PURCHASE_DETAILS ALTER COLUMN ID ADD GENERATED BY DEFAULT AS IDENTITY;
+SELECT setval(pg_get_serial_sequence('purchase_details', 'id'), COALESCE(max(id), 0) + 1, false) FROM purchase_details;
+ALTER TABLE PURCHASE_DETAILS ADD PRIMARY KEY (ID);
+ALTER TABLE PURCHASE_DETAILS ADD CONSTRAINT PURCHASE_DETAILS_QUANTITY_CHECK CHECK (QUANTITY > 0);
+
+-- the total is recomputed from the detail lines instead of incremented,
+-- so it stays correct when a line is updated, deleted or moved to another invoice
+CREATE OR REPLACE FUNCTION set_purchase_invoice_total()
+   RETURNS TRIGGER
+   LANGUAGE PLPGSQL
+AS
+$$
+BEGIN
+   IF TG_OP IN ('UPDATE', 'DELETE') THEN
+      UPDATE PURCHASE_INVOICES AS p
+      SET TOTAL = (SELECT COALESCE(SUM(d.PRICE * d.QUANTITY), 0) FROM PURCHASE_DETAILS AS d WHERE d.PURCHASE_INVOICE_ID = OLD.PURCHASE_INVOICE_ID)
+      WHERE p.ID = OLD.PURCHASE_INVOICE_ID;
+   END IF;
+   IF TG_OP IN ('INSERT', 'UPDATE') THEN
+      UPDATE PURCHASE_INVOICES AS p
+      SET TOTAL = (SELECT COALESCE(SUM(d.PRICE * d.QUANTITY), 0) FROM PURCHASE_DETAILS AS d WHERE d.PURCHASE_INVOICE_ID = NEW.PURCHASE_INVOICE_ID)
+      WHERE p.ID = NEW.PURCHASE_INVOICE_ID;
+   END IF;
+   RETURN NULL;
+END;
+$$;
+DROP TRIGGER IF EXISTS set_purchase_invoice_total_trigger on PURCHASE_DETAILS;
+CREATE TRIGGER set_purchase_invoice_total_trigger
+    AFTER INSERT OR UPDATE OR DELETE
+    ON PURCHASE_DETAILS
+    FOR EACH ROW
+EXECUTE PROCEDURE set_purchase_invoice_total();
+
+-- fix the totals that drifted
+UPDATE PURCHASE_INVOICES AS p
+SET TOTAL = (SELECT COALESCE(SUM(d.PRICE * d.QUANTITY), 0) FROM PURCHASE_DETAILS AS d WHERE d.PURCHASE_INVOICE_ID = p.ID);
+ALTER TABLE PURCHASE_INVOICES ALTER COLUMN TOTAL SET NOT NULL;
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS SALE_INVOICE_LINES;
+DROP SEQUENCE IF EXISTS SALE_INVOICE_REF_SEQ;
+
+ALTER TABLE SALE_INVOICES DROP CONSTRAINT IF EXISTS SALE_INVOICES_SERVICE_ORDER_ID_KEY;
+ALTER TABLE SALE_INVOICES DROP COLUMN IF EXISTS TOTAL;
+ALTER TABLE SALE_INVOICES ALTER COLUMN ID DROP IDENTITY IF EXISTS;
@@ -0,0 +1,22 @@
+ALTER TABLE SALE_INVOICES ALTER COLUMN ID ADD GENERATED BY DEFAULT AS IDENTITY;
+SELECT setval(pg_get_serial_sequence('sale_invoices', 'id'), COALESCE(max(id), 0) + 1, false) FROM sale_invoices;
+ALTER TABLE SALE_INVOICES ADD COLUMN TOTAL DECIMAL NOT NULL DEFAULT 0;
+ALTER TABLE SALE_INVOICES ADD CONSTRAINT SALE_INVOICES_SERVICE_ORDER_ID_KEY UNIQUE (SERVICE_ORDER_ID);
+
+CREATE SEQUENCE SALE_INVOICE_REF_SEQ;
+
+-- the lines are copied from the service order when the invoice is issued,
+-- so later price changes in the catalog or on the order do not rewrite the invoice
+CREATE TABLE SALE_INVOICE_LINES (
+    ID INT NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+    SALE_INVOICE_ID INT NOT NULL,
+    KIND VARCHAR(16) NOT NULL CHECK (KIND IN ('service', 'part')),
+    DESCRIPTION VARCHAR(255) NOT NULL,
+    QUANTITY INT NOT NULL CHECK (QUANTITY > 0),
+    UNIT_PRICE DECIMAL NOT NULL,
+    AMOUNT DECIMAL NOT NULL,
+    PRIMARY KEY (ID),
+    FOREIGN KEY (SALE_INVOICE_ID) REFERENCES SALE_INVOICES (ID) ON DELETE CASCADE
+);
+
+CREATE INDEX SALE_INVOICE_LINES_SALE_INVOICE_ID_IDX ON SALE_INVOICE_LINES(SALE_INVOICE_ID);
@@ -0,0 +1,8 @@
+DROP VIEW IF EXISTS SALE_INVOICE_BALANCES;
+DROP FUNCTION IF EXISTS sale_invoice_paid(INT);
+DROP INDEX IF EXISTS PAYMENTS_SALE_INVOICE_ID_IDX;
+
+ALTER TABLE PAYMENTS ALTER COLUMN date DROP NOT NULL;
+ALTER TABLE PAYMENTS ALTER COLUMN date DROP DEFAULT;
+ALTER TABLE PAYMENTS ALTER COLUMN AMOUNT DROP NOT NULL;
+ALTER TABLE PAYMENTS ALTER COLUMN ID DROP IDENTITY IF EXISTS;
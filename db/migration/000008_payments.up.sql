@@ -0,0 +1,35 @@
+ALTER TABLE PAYMENTS ALTER COLUMN ID ADD GENERATED BY DEFAULT AS IDENTITY;
+SELECT setval(pg_get_serial_sequence('payments', 'id'), COALESCE(max(id), 0) + 1, false) FROM payments;
+ALTER TABLE PAYMENTS ALTER COLUMN AMOUNT SET NOT NULL;
+UPDATE PAYMENTS SET date = CURRENT_DATE WHERE date IS NULL;
+ALTER TABLE PAYMENTS ALTER COLUMN date SET DEFAULT CURRENT_DATE;
+ALTER TABLE PAYMENTS ALTER COLUMN date SET NOT NULL;
+
+CREATE INDEX PAYMENTS_SALE_INVOICE_ID_IDX ON PAYMENTS(SALE_INVOICE_ID);
+
+CREATE FUNCTION sale_invoice_paid(invoice_id INT) RETURNS decimal AS $$
+    SELECT COALESCE(SUM(AMOUNT), 0) FROM PAYMENTS WHERE SALE_INVOICE_ID = invoice_id;
+$$ LANGUAGE SQL STABLE;
+
+CREATE VIEW SALE_INVOICE_BALANCES AS
+SELECT
+    si.ID AS SALE_INVOICE_ID,
+    si.SERVICE_ORDER_ID,
+    si.REF,
+    si.date,
+    si.TOTAL,
+    sale_invoice_paid(si.ID)::decimal AS PAID,
+    (si.TOTAL - sale_invoice_paid(si.ID))::decimal AS OUTSTANDING,
+    (CASE
+        WHEN sale_invoice_paid(si.ID) > si.TOTAL THEN 'overpaid'
+        WHEN sale_invoice_paid(si.ID) = si.TOTAL THEN 'paid'
+        WHEN sale_invoice_paid(si.ID) = 0 THEN 'unpaid'
+        ELSE 'partial'
+    END)::text AS STATUS,
+    (CURRENT_DATE - COALESCE(si.date, CURRENT_DATE))::int AS AGE_DAYS,
+    (CASE
+        WHEN CURRENT_DATE - COALESCE(si.date, CURRENT_DATE) <= 30 THEN '0-30'
+        WHEN CURRENT_DATE - COALESCE(si.date, CURRENT_DATE) <= 60 THEN '31-60'
+        ELSE '60+'
+    END)::text AS AGING_BUCKET
+FROM SALE_INVOICES si;
@@ -0,0 +1,9 @@
+CREATE TABLE USERS (
+    USERNAME VARCHAR(255) NOT NULL,
+    HASHED_PASSWORD VARCHAR(255) NOT NULL,
+    FULL_NAME VARCHAR(255) NOT NULL,
+    ROLE VARCHAR(255) NOT NULL CHECK (ROLE IN ('admin', 'front_desk', 'mechanic', 'accountant')),
+    PASSWORD_CHANGED_AT TIMESTAMPTZ NOT NULL DEFAULT '0001-01-01 00:00:00Z',
+    CREATED_AT TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (USERNAME)
+);
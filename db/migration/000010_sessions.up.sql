@@ -0,0 +1,13 @@
+CREATE TABLE SESSIONS (
+    ID UUID NOT NULL,
+    USERNAME VARCHAR(255) NOT NULL REFERENCES USERS(USERNAME),
+    REFRESH_TOKEN VARCHAR NOT NULL,
+    USER_AGENT VARCHAR NOT NULL,
+    CLIENT_IP VARCHAR NOT NULL,
+    IS_BLOCKED BOOLEAN NOT NULL DEFAULT false,
+    EXPIRES_AT TIMESTAMPTZ NOT NULL,
+    CREATED_AT TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (ID)
+);
+
+CREATE INDEX SESSIONS_USERNAME_IDX ON SESSIONS(USERNAME);
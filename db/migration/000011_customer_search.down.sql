@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS CUSTOMERS_PHONE_DIGITS_TRGM_IDX;
+DROP INDEX IF EXISTS CUSTOMERS_FULL_NAME_TRGM_IDX;
+DROP FUNCTION IF EXISTS phone_digits(TEXT);
+DROP EXTENSION IF EXISTS pg_trgm;
@@ -0,0 +1,9 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- the digits of a phone number, so "0550 12-34-56" and "0550123456" are searched alike
+CREATE FUNCTION phone_digits(phone TEXT) RETURNS TEXT AS $$
+    SELECT regexp_replace(phone, '[^0-9]', '', 'g');
+$$ LANGUAGE SQL IMMUTABLE;
+
+CREATE INDEX CUSTOMERS_FULL_NAME_TRGM_IDX ON CUSTOMERS USING GIN (FULL_NAME gin_trgm_ops);
+CREATE INDEX CUSTOMERS_PHONE_DIGITS_TRGM_IDX ON CUSTOMERS USING GIN (phone_digits(PHONE_NUMBER) gin_trgm_ops);
@@ -0,0 +1,3 @@
+ALTER TABLE SERVICE_ORDERS DROP COLUMN IF EXISTS VERSION;
+ALTER TABLE CARS DROP COLUMN IF EXISTS VERSION;
+ALTER TABLE CUSTOMERS DROP COLUMN IF EXISTS VERSION;
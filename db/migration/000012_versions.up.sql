@@ -0,0 +1,5 @@
+-- the version of a row is bumped by every update, the API hands it out as the ETag of the
+-- row and only changes a row when the If-Match of the request holds its current version
+ALTER TABLE CUSTOMERS ADD COLUMN VERSION INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE CARS ADD COLUMN VERSION INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE SERVICE_ORDERS ADD COLUMN VERSION INTEGER NOT NULL DEFAULT 1;
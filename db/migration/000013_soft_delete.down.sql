@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS CARS_REGISTRAION_NUMBER_KEY;
+ALTER TABLE CARS ADD CONSTRAINT cars_registraion_number_key UNIQUE (REGISTRAION_NUMBER);
+DROP INDEX IF EXISTS CUSTOMERS_PHONE_NUMBER_KEY;
+ALTER TABLE CUSTOMERS ADD CONSTRAINT customers_phone_number_key UNIQUE (PHONE_NUMBER);
+
+ALTER TABLE CARS DROP COLUMN IF EXISTS DELETED_AT;
+ALTER TABLE CUSTOMERS DROP COLUMN IF EXISTS DELETED_AT;
@@ -0,0 +1,10 @@
+-- customers and cars are soft deleted so their service orders and invoices are kept, the
+-- queries of the API hide the rows with a DELETED_AT
+ALTER TABLE CUSTOMERS ADD COLUMN DELETED_AT TIMESTAMPTZ;
+ALTER TABLE CARS ADD COLUMN DELETED_AT TIMESTAMPTZ;
+
+-- a deleted customer or car does not keep its phone or registration number from being reused
+ALTER TABLE CUSTOMERS DROP CONSTRAINT customers_phone_number_key;
+CREATE UNIQUE INDEX CUSTOMERS_PHONE_NUMBER_KEY ON CUSTOMERS (PHONE_NUMBER) WHERE DELETED_AT IS NULL;
+ALTER TABLE CARS DROP CONSTRAINT cars_registraion_number_key;
+CREATE UNIQUE INDEX CARS_REGISTRAION_NUMBER_KEY ON CARS (REGISTRAION_NUMBER) WHERE DELETED_AT IS NULL;
@@ -0,0 +1,14 @@
+ALTER TABLE SERVICE_DETAILS DROP CONSTRAINT IF EXISTS service_details_service_id_fkey;
+ALTER TABLE SERVICE_DETAILS ADD CONSTRAINT service_details_service_id_fkey
+    FOREIGN KEY (SERVICE_ID) REFERENCES SERVICES (ID) ON DELETE CASCADE;
+
+ALTER TABLE SERVICE_DETAILS DROP COLUMN IF EXISTS PRICE_OVERRIDDEN_BY;
+ALTER TABLE SERVICE_DETAILS DROP COLUMN IF EXISTS PRICE_OVERRIDE_REASON;
+
+ALTER TABLE SERVICES DROP CONSTRAINT IF EXISTS SERVICES_PRICE_RANGE_CHECK;
+ALTER TABLE SERVICES ADD CONSTRAINT services_check CHECK (MAX_PRICE > MIN_PRICE);
+ALTER TABLE SERVICES DROP CONSTRAINT IF EXISTS SERVICES_ESTIMATION_TIME_CHECK;
+
+ALTER TABLE SERVICE_DETAILS ALTER COLUMN STATE DROP DEFAULT;
+ALTER TABLE SERVICE_DETAILS ALTER COLUMN ID DROP IDENTITY IF EXISTS;
+ALTER TABLE SERVICES ALTER COLUMN ID DROP IDENTITY IF EXISTS;
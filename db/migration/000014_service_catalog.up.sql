@@ -0,0 +1,19 @@
+ALTER TABLE SERVICES ALTER COLUMN ID ADD GENERATED BY DEFAULT AS IDENTITY;
+SELECT setval(pg_get_serial_sequence('services', 'id'), COALESCE(max(id), 0) + 1, false) FROM services;
+ALTER TABLE SERVICE_DETAILS ALTER COLUMN ID ADD GENERATED BY DEFAULT AS IDENTITY;
+SELECT setval(pg_get_serial_sequence('service_details', 'id'), COALESCE(max(id), 0) + 1, false) FROM service_details;
+ALTER TABLE SERVICE_DETAILS ALTER COLUMN STATE SET DEFAULT 1;
+
+-- ESTIMATION_TIME is in minutes, a service with a fixed price has the same min and max price
+ALTER TABLE SERVICES ADD CONSTRAINT SERVICES_ESTIMATION_TIME_CHECK CHECK (ESTIMATION_TIME > 0);
+ALTER TABLE SERVICES DROP CONSTRAINT services_check;
+ALTER TABLE SERVICES ADD CONSTRAINT SERVICES_PRICE_RANGE_CHECK CHECK (MAX_PRICE >= MIN_PRICE);
+
+-- a price outside the range of the catalog is only charged by an admin, who gives the reason
+ALTER TABLE SERVICE_DETAILS ADD COLUMN PRICE_OVERRIDE_REASON VARCHAR(255);
+ALTER TABLE SERVICE_DETAILS ADD COLUMN PRICE_OVERRIDDEN_BY VARCHAR(255) REFERENCES USERS(USERNAME);
+
+-- deleting a service of the catalog must not delete the lines of the service orders using it
+ALTER TABLE SERVICE_DETAILS DROP CONSTRAINT service_details_service_id_fkey;
+ALTER TABLE SERVICE_DETAILS ADD CONSTRAINT service_details_service_id_fkey
+    FOREIGN KEY (SERVICE_ID) REFERENCES SERVICES (ID) ON DELETE RESTRICT;
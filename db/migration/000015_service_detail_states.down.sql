@@ -0,0 +1,4 @@
+ALTER TABLE SERVICE_DETAILS DROP COLUMN IF EXISTS STOPPED_AT;
+ALTER TABLE SERVICE_DETAILS DROP COLUMN IF EXISTS STARTED_AT;
+
+ALTER TABLE SERVICE_DETAILS DROP CONSTRAINT IF EXISTS SERVICE_DETAILS_STATE_CHECK;
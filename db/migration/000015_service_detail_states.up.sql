@@ -0,0 +1,7 @@
+-- 1 pending, 2 started, 3 paused, 4 done, 5 declined by the customer
+UPDATE SERVICE_DETAILS SET STATE = 1 WHERE STATE NOT BETWEEN 1 AND 5;
+ALTER TABLE SERVICE_DETAILS ADD CONSTRAINT SERVICE_DETAILS_STATE_CHECK CHECK (STATE BETWEEN 1 AND 5);
+
+-- when the work on the line first started and when it last stopped, it is NULL while the line is started
+ALTER TABLE SERVICE_DETAILS ADD COLUMN STARTED_AT TIMESTAMPTZ;
+ALTER TABLE SERVICE_DETAILS ADD COLUMN STOPPED_AT TIMESTAMPTZ;
@@ -0,0 +1,17 @@
+-- the time a mechanic spent on a service line, from clocking in to clocking out
+CREATE TABLE LABOR_ENTRIES (
+    ID INT NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+    MECHANIC_ID INT NOT NULL,
+    SERVICE_DETAIL_ID INT NOT NULL,
+    CLOCKED_IN_AT TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CLOCKED_OUT_AT TIMESTAMPTZ,
+    PRIMARY KEY (ID),
+    -- the hours worked are kept when a mechanic leaves
+    FOREIGN KEY (MECHANIC_ID) REFERENCES MECHANICS (ID) ON DELETE RESTRICT,
+    FOREIGN KEY (SERVICE_DETAIL_ID) REFERENCES SERVICE_DETAILS (ID) ON DELETE CASCADE,
+    CHECK (CLOCKED_OUT_AT >= CLOCKED_IN_AT)
+);
+
+-- a mechanic is clocked in on one service line at a time
+CREATE UNIQUE INDEX LABOR_ENTRIES_CLOCKED_IN_KEY ON LABOR_ENTRIES (MECHANIC_ID) WHERE CLOCKED_OUT_AT IS NULL;
+CREATE INDEX LABOR_ENTRIES_SERVICE_DETAIL_ID_IDX ON LABOR_ENTRIES (SERVICE_DETAIL_ID);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS APPOINTMENT_SERVICES;
+DROP TABLE IF EXISTS APPOINTMENTS;
@@ -0,0 +1,31 @@
+-- a visit booked over the phone, on a bay of the workshop for the time its services take
+CREATE TABLE APPOINTMENTS (
+    ID INT NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+    CUSTOMER_ID BIGINT NOT NULL,
+    CAR_ID INT NOT NULL,
+    BAY INT NOT NULL CHECK (BAY >= 1),
+    STARTS_AT TIMESTAMPTZ NOT NULL,
+    ENDS_AT TIMESTAMPTZ NOT NULL,
+    NOTES VARCHAR(255) NOT NULL DEFAULT '',
+    -- 1 booked, 2 arrived, 3 cancelled
+    STATE INT NOT NULL DEFAULT 1 CHECK (STATE BETWEEN 1 AND 3),
+    -- the service order opened when the car arrived
+    SERVICE_ORDER_ID INT UNIQUE,
+    CREATED_AT TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (ID),
+    FOREIGN KEY (CUSTOMER_ID) REFERENCES CUSTOMERS (ID) ON DELETE CASCADE,
+    FOREIGN KEY (CAR_ID) REFERENCES CARS (ID) ON DELETE CASCADE,
+    FOREIGN KEY (SERVICE_ORDER_ID) REFERENCES SERVICE_ORDERS (ID) ON DELETE SET NULL,
+    CHECK (ENDS_AT > STARTS_AT)
+);
+
+CREATE INDEX APPOINTMENTS_STARTS_AT_IDX ON APPOINTMENTS (STARTS_AT);
+
+-- the services the customer asked for, they become the service lines of the order
+CREATE TABLE APPOINTMENT_SERVICES (
+    APPOINTMENT_ID INT NOT NULL,
+    SERVICE_ID INT NOT NULL,
+    PRIMARY KEY (APPOINTMENT_ID, SERVICE_ID),
+    FOREIGN KEY (APPOINTMENT_ID) REFERENCES APPOINTMENTS (ID) ON DELETE CASCADE,
+    FOREIGN KEY (SERVICE_ID) REFERENCES SERVICES (ID) ON DELETE RESTRICT
+);
@@ -0,0 +1 @@
+ALTER TABLE SERVICE_ORDERS DROP COLUMN IF EXISTS MILEAGE;
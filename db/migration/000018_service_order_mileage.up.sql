@@ -0,0 +1,2 @@
+-- the odometer reading of the car when it was received, in kilometers
+ALTER TABLE SERVICE_ORDERS ADD COLUMN MILEAGE INT CHECK (MILEAGE >= 0);
@@ -0,0 +1,4 @@
+CREATE SEQUENCE IF NOT EXISTS SALE_INVOICE_REF_SEQ;
+SELECT setval('sale_invoice_ref_seq', GREATEST(LAST_REF, 1), LAST_REF > 0) FROM SALE_INVOICE_REF_COUNTER;
+
+DROP TABLE IF EXISTS SALE_INVOICE_REF_COUNTER;
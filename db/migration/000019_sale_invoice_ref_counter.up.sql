@@ -0,0 +1,11 @@
+-- a sequence does not roll back, so a failed invoice would leave a gap in the refs; the
+-- counter row is updated in the invoicing transaction and rolls back with it
+CREATE TABLE SALE_INVOICE_REF_COUNTER (
+    ID BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (ID),
+    LAST_REF BIGINT NOT NULL CHECK (LAST_REF >= 0)
+);
+
+INSERT INTO SALE_INVOICE_REF_COUNTER (LAST_REF)
+SELECT CASE WHEN is_called THEN last_value ELSE 0 END FROM SALE_INVOICE_REF_SEQ;
+
+DROP SEQUENCE SALE_INVOICE_REF_SEQ;
@@ -0,0 +1,2331 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/sqlc (interfaces: Store)
+
+// Package mockdb is a generated GoMock package.
+package mockdb
+
+import (
+	context "context"
+	reflect "reflect"
+
+	db "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/db/sqlc"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockStore is a mock of Store interface.
+type MockStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreMockRecorder
+}
+
+// MockStoreMockRecorder is the mock recorder for MockStore.
+type MockStoreMockRecorder struct {
+	mock *MockStore
+}
+
+// NewMockStore creates a new mock instance.
+func NewMockStore(ctrl *gomock.Controller) *MockStore {
+	mock := &MockStore{ctrl: ctrl}
+	mock.recorder = &MockStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStore) EXPECT() *MockStoreMockRecorder {
+	return m.recorder
+}
+
+// AddAppointmentService mocks base method.
+func (m *MockStore) AddAppointmentService(arg0 context.Context, arg1 db.AddAppointmentServiceParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAppointmentService", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAppointmentService indicates an expected call of AddAppointmentService.
+func (mr *MockStoreMockRecorder) AddAppointmentService(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAppointmentService", reflect.TypeOf((*MockStore)(nil).AddAppointmentService), arg0, arg1)
+}
+
+// AddPartDetailTx mocks base method.
+func (m *MockStore) AddPartDetailTx(arg0 context.Context, arg1 db.AddPartDetailTxParams) (db.PartDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPartDetailTx", arg0, arg1)
+	ret0, _ := ret[0].(db.PartDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPartDetailTx indicates an expected call of AddPartDetailTx.
+func (mr *MockStoreMockRecorder) AddPartDetailTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPartDetailTx", reflect.TypeOf((*MockStore)(nil).AddPartDetailTx), arg0, arg1)
+}
+
+// AddPurchaseDetailTx mocks base method.
+func (m *MockStore) AddPurchaseDetailTx(arg0 context.Context, arg1 db.CreatePurchaseDetailParams) (db.PurchaseDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPurchaseDetailTx", arg0, arg1)
+	ret0, _ := ret[0].(db.PurchaseDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPurchaseDetailTx indicates an expected call of AddPurchaseDetailTx.
+func (mr *MockStoreMockRecorder) AddPurchaseDetailTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPurchaseDetailTx", reflect.TypeOf((*MockStore)(nil).AddPurchaseDetailTx), arg0, arg1)
+}
+
+// AddServiceDetailTx mocks base method.
+func (m *MockStore) AddServiceDetailTx(arg0 context.Context, arg1 db.AddServiceDetailTxParams) (db.ServiceDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddServiceDetailTx", arg0, arg1)
+	ret0, _ := ret[0].(db.ServiceDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddServiceDetailTx indicates an expected call of AddServiceDetailTx.
+func (mr *MockStoreMockRecorder) AddServiceDetailTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddServiceDetailTx", reflect.TypeOf((*MockStore)(nil).AddServiceDetailTx), arg0, arg1)
+}
+
+// ArriveAppointmentTx mocks base method.
+func (m *MockStore) ArriveAppointmentTx(arg0 context.Context, arg1 db.ArriveAppointmentTxParams) (db.ArriveAppointmentTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArriveAppointmentTx", arg0, arg1)
+	ret0, _ := ret[0].(db.ArriveAppointmentTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArriveAppointmentTx indicates an expected call of ArriveAppointmentTx.
+func (mr *MockStoreMockRecorder) ArriveAppointmentTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArriveAppointmentTx", reflect.TypeOf((*MockStore)(nil).ArriveAppointmentTx), arg0, arg1)
+}
+
+// AssignMechanic mocks base method.
+func (m *MockStore) AssignMechanic(arg0 context.Context, arg1 db.AssignMechanicParams) (db.MechanicDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignMechanic", arg0, arg1)
+	ret0, _ := ret[0].(db.MechanicDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignMechanic indicates an expected call of AssignMechanic.
+func (mr *MockStoreMockRecorder) AssignMechanic(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignMechanic", reflect.TypeOf((*MockStore)(nil).AssignMechanic), arg0, arg1)
+}
+
+// BlockSession mocks base method.
+func (m *MockStore) BlockSession(arg0 context.Context, arg1 db.BlockSessionParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockSession", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockSession indicates an expected call of BlockSession.
+func (mr *MockStoreMockRecorder) BlockSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockSession", reflect.TypeOf((*MockStore)(nil).BlockSession), arg0, arg1)
+}
+
+// BookAppointmentTx mocks base method.
+func (m *MockStore) BookAppointmentTx(arg0 context.Context, arg1 db.BookAppointmentTxParams) (db.BookAppointmentTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BookAppointmentTx", arg0, arg1)
+	ret0, _ := ret[0].(db.BookAppointmentTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BookAppointmentTx indicates an expected call of BookAppointmentTx.
+func (mr *MockStoreMockRecorder) BookAppointmentTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BookAppointmentTx", reflect.TypeOf((*MockStore)(nil).BookAppointmentTx), arg0, arg1)
+}
+
+// ClockInTx mocks base method.
+func (m *MockStore) ClockInTx(arg0 context.Context, arg1 db.ClockInTxParams) (db.LaborEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClockInTx", arg0, arg1)
+	ret0, _ := ret[0].(db.LaborEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClockInTx indicates an expected call of ClockInTx.
+func (mr *MockStoreMockRecorder) ClockInTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClockInTx", reflect.TypeOf((*MockStore)(nil).ClockInTx), arg0, arg1)
+}
+
+// ClockOutMechanic mocks base method.
+func (m *MockStore) ClockOutMechanic(arg0 context.Context, arg1 int32) (db.LaborEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClockOutMechanic", arg0, arg1)
+	ret0, _ := ret[0].(db.LaborEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClockOutMechanic indicates an expected call of ClockOutMechanic.
+func (mr *MockStoreMockRecorder) ClockOutMechanic(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClockOutMechanic", reflect.TypeOf((*MockStore)(nil).ClockOutMechanic), arg0, arg1)
+}
+
+// ClockOutServiceDetail mocks base method.
+func (m *MockStore) ClockOutServiceDetail(arg0 context.Context, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClockOutServiceDetail", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClockOutServiceDetail indicates an expected call of ClockOutServiceDetail.
+func (mr *MockStoreMockRecorder) ClockOutServiceDetail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClockOutServiceDetail", reflect.TypeOf((*MockStore)(nil).ClockOutServiceDetail), arg0, arg1)
+}
+
+// CountCarsWhere mocks base method.
+func (m *MockStore) CountCarsWhere(arg0 context.Context, arg1 []db.Condition) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountCarsWhere", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountCarsWhere indicates an expected call of CountCarsWhere.
+func (mr *MockStoreMockRecorder) CountCarsWhere(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCarsWhere", reflect.TypeOf((*MockStore)(nil).CountCarsWhere), arg0, arg1)
+}
+
+// CountCustomerInvoices mocks base method.
+func (m *MockStore) CountCustomerInvoices(arg0 context.Context, arg1 int32) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountCustomerInvoices", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountCustomerInvoices indicates an expected call of CountCustomerInvoices.
+func (mr *MockStoreMockRecorder) CountCustomerInvoices(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCustomerInvoices", reflect.TypeOf((*MockStore)(nil).CountCustomerInvoices), arg0, arg1)
+}
+
+// CountCustomers mocks base method.
+func (m *MockStore) CountCustomers(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountCustomers", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountCustomers indicates an expected call of CountCustomers.
+func (mr *MockStoreMockRecorder) CountCustomers(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCustomers", reflect.TypeOf((*MockStore)(nil).CountCustomers), arg0)
+}
+
+// CountCustomersWhere mocks base method.
+func (m *MockStore) CountCustomersWhere(arg0 context.Context, arg1 []db.Condition) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountCustomersWhere", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountCustomersWhere indicates an expected call of CountCustomersWhere.
+func (mr *MockStoreMockRecorder) CountCustomersWhere(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCustomersWhere", reflect.TypeOf((*MockStore)(nil).CountCustomersWhere), arg0, arg1)
+}
+
+// CountMechanics mocks base method.
+func (m *MockStore) CountMechanics(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountMechanics", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountMechanics indicates an expected call of CountMechanics.
+func (mr *MockStoreMockRecorder) CountMechanics(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountMechanics", reflect.TypeOf((*MockStore)(nil).CountMechanics), arg0)
+}
+
+// CountPartsWhere mocks base method.
+func (m *MockStore) CountPartsWhere(arg0 context.Context, arg1 []db.Condition) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPartsWhere", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPartsWhere indicates an expected call of CountPartsWhere.
+func (mr *MockStoreMockRecorder) CountPartsWhere(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPartsWhere", reflect.TypeOf((*MockStore)(nil).CountPartsWhere), arg0, arg1)
+}
+
+// CountPurchaseInvoices mocks base method.
+func (m *MockStore) CountPurchaseInvoices(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPurchaseInvoices", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPurchaseInvoices indicates an expected call of CountPurchaseInvoices.
+func (mr *MockStoreMockRecorder) CountPurchaseInvoices(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPurchaseInvoices", reflect.TypeOf((*MockStore)(nil).CountPurchaseInvoices), arg0)
+}
+
+// CountSaleInvoices mocks base method.
+func (m *MockStore) CountSaleInvoices(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSaleInvoices", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSaleInvoices indicates an expected call of CountSaleInvoices.
+func (mr *MockStoreMockRecorder) CountSaleInvoices(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSaleInvoices", reflect.TypeOf((*MockStore)(nil).CountSaleInvoices), arg0)
+}
+
+// CountServiceDetailLaborEntries mocks base method.
+func (m *MockStore) CountServiceDetailLaborEntries(arg0 context.Context, arg1 int32) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountServiceDetailLaborEntries", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountServiceDetailLaborEntries indicates an expected call of CountServiceDetailLaborEntries.
+func (mr *MockStoreMockRecorder) CountServiceDetailLaborEntries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountServiceDetailLaborEntries", reflect.TypeOf((*MockStore)(nil).CountServiceDetailLaborEntries), arg0, arg1)
+}
+
+// CountServiceOrdersWhere mocks base method.
+func (m *MockStore) CountServiceOrdersWhere(arg0 context.Context, arg1 []db.Condition) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountServiceOrdersWhere", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountServiceOrdersWhere indicates an expected call of CountServiceOrdersWhere.
+func (mr *MockStoreMockRecorder) CountServiceOrdersWhere(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountServiceOrdersWhere", reflect.TypeOf((*MockStore)(nil).CountServiceOrdersWhere), arg0, arg1)
+}
+
+// CountServices mocks base method.
+func (m *MockStore) CountServices(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountServices", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountServices indicates an expected call of CountServices.
+func (mr *MockStoreMockRecorder) CountServices(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountServices", reflect.TypeOf((*MockStore)(nil).CountServices), arg0)
+}
+
+// CountSuppliers mocks base method.
+func (m *MockStore) CountSuppliers(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSuppliers", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSuppliers indicates an expected call of CountSuppliers.
+func (mr *MockStoreMockRecorder) CountSuppliers(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSuppliers", reflect.TypeOf((*MockStore)(nil).CountSuppliers), arg0)
+}
+
+// CountUnfinishedServiceDetails mocks base method.
+func (m *MockStore) CountUnfinishedServiceDetails(arg0 context.Context, arg1 db.CountUnfinishedServiceDetailsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUnfinishedServiceDetails", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUnfinishedServiceDetails indicates an expected call of CountUnfinishedServiceDetails.
+func (mr *MockStoreMockRecorder) CountUnfinishedServiceDetails(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnfinishedServiceDetails", reflect.TypeOf((*MockStore)(nil).CountUnfinishedServiceDetails), arg0, arg1)
+}
+
+// CountUsers mocks base method.
+func (m *MockStore) CountUsers(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUsers", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUsers indicates an expected call of CountUsers.
+func (mr *MockStoreMockRecorder) CountUsers(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUsers", reflect.TypeOf((*MockStore)(nil).CountUsers), arg0)
+}
+
+// CreateAppointment mocks base method.
+func (m *MockStore) CreateAppointment(arg0 context.Context, arg1 db.CreateAppointmentParams) (db.Appointment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAppointment", arg0, arg1)
+	ret0, _ := ret[0].(db.Appointment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAppointment indicates an expected call of CreateAppointment.
+func (mr *MockStoreMockRecorder) CreateAppointment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAppointment", reflect.TypeOf((*MockStore)(nil).CreateAppointment), arg0, arg1)
+}
+
+// CreateCar mocks base method.
+func (m *MockStore) CreateCar(arg0 context.Context, arg1 db.CreateCarParams) (db.Car, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCar", arg0, arg1)
+	ret0, _ := ret[0].(db.Car)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCar indicates an expected call of CreateCar.
+func (mr *MockStoreMockRecorder) CreateCar(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCar", reflect.TypeOf((*MockStore)(nil).CreateCar), arg0, arg1)
+}
+
+// CreateCustomer mocks base method.
+func (m *MockStore) CreateCustomer(arg0 context.Context, arg1 db.CreateCustomerParams) (db.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCustomer", arg0, arg1)
+	ret0, _ := ret[0].(db.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCustomer indicates an expected call of CreateCustomer.
+func (mr *MockStoreMockRecorder) CreateCustomer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomer", reflect.TypeOf((*MockStore)(nil).CreateCustomer), arg0, arg1)
+}
+
+// CreateFirstAdmin mocks base method.
+func (m *MockStore) CreateFirstAdmin(arg0 context.Context, arg1 db.CreateFirstAdminParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFirstAdmin", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFirstAdmin indicates an expected call of CreateFirstAdmin.
+func (mr *MockStoreMockRecorder) CreateFirstAdmin(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFirstAdmin", reflect.TypeOf((*MockStore)(nil).CreateFirstAdmin), arg0, arg1)
+}
+
+// CreateFirstAdminTx mocks base method.
+func (m *MockStore) CreateFirstAdminTx(arg0 context.Context, arg1 db.CreateFirstAdminParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFirstAdminTx", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFirstAdminTx indicates an expected call of CreateFirstAdminTx.
+func (mr *MockStoreMockRecorder) CreateFirstAdminTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFirstAdminTx", reflect.TypeOf((*MockStore)(nil).CreateFirstAdminTx), arg0, arg1)
+}
+
+// CreateLaborEntry mocks base method.
+func (m *MockStore) CreateLaborEntry(arg0 context.Context, arg1 db.CreateLaborEntryParams) (db.LaborEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLaborEntry", arg0, arg1)
+	ret0, _ := ret[0].(db.LaborEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLaborEntry indicates an expected call of CreateLaborEntry.
+func (mr *MockStoreMockRecorder) CreateLaborEntry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLaborEntry", reflect.TypeOf((*MockStore)(nil).CreateLaborEntry), arg0, arg1)
+}
+
+// CreateMechanic mocks base method.
+func (m *MockStore) CreateMechanic(arg0 context.Context, arg1 string) (db.Mechanic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMechanic", arg0, arg1)
+	ret0, _ := ret[0].(db.Mechanic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMechanic indicates an expected call of CreateMechanic.
+func (mr *MockStoreMockRecorder) CreateMechanic(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMechanic", reflect.TypeOf((*MockStore)(nil).CreateMechanic), arg0, arg1)
+}
+
+// CreatePart mocks base method.
+func (m *MockStore) CreatePart(arg0 context.Context, arg1 db.CreatePartParams) (db.Part, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePart", arg0, arg1)
+	ret0, _ := ret[0].(db.Part)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePart indicates an expected call of CreatePart.
+func (mr *MockStoreMockRecorder) CreatePart(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePart", reflect.TypeOf((*MockStore)(nil).CreatePart), arg0, arg1)
+}
+
+// CreatePartDetail mocks base method.
+func (m *MockStore) CreatePartDetail(arg0 context.Context, arg1 db.CreatePartDetailParams) (db.PartDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePartDetail", arg0, arg1)
+	ret0, _ := ret[0].(db.PartDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePartDetail indicates an expected call of CreatePartDetail.
+func (mr *MockStoreMockRecorder) CreatePartDetail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePartDetail", reflect.TypeOf((*MockStore)(nil).CreatePartDetail), arg0, arg1)
+}
+
+// CreatePayment mocks base method.
+func (m *MockStore) CreatePayment(arg0 context.Context, arg1 db.CreatePaymentParams) (db.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePayment", arg0, arg1)
+	ret0, _ := ret[0].(db.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePayment indicates an expected call of CreatePayment.
+func (mr *MockStoreMockRecorder) CreatePayment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePayment", reflect.TypeOf((*MockStore)(nil).CreatePayment), arg0, arg1)
+}
+
+// CreatePurchaseDetail mocks base method.
+func (m *MockStore) CreatePurchaseDetail(arg0 context.Context, arg1 db.CreatePurchaseDetailParams) (db.PurchaseDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePurchaseDetail", arg0, arg1)
+	ret0, _ := ret[0].(db.PurchaseDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePurchaseDetail indicates an expected call of CreatePurchaseDetail.
+func (mr *MockStoreMockRecorder) CreatePurchaseDetail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePurchaseDetail", reflect.TypeOf((*MockStore)(nil).CreatePurchaseDetail), arg0, arg1)
+}
+
+// CreatePurchaseInvoice mocks base method.
+func (m *MockStore) CreatePurchaseInvoice(arg0 context.Context, arg1 db.CreatePurchaseInvoiceParams) (db.PurchaseInvoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePurchaseInvoice", arg0, arg1)
+	ret0, _ := ret[0].(db.PurchaseInvoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePurchaseInvoice indicates an expected call of CreatePurchaseInvoice.
+func (mr *MockStoreMockRecorder) CreatePurchaseInvoice(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePurchaseInvoice", reflect.TypeOf((*MockStore)(nil).CreatePurchaseInvoice), arg0, arg1)
+}
+
+// CreatePurchaseInvoiceTx mocks base method.
+func (m *MockStore) CreatePurchaseInvoiceTx(arg0 context.Context, arg1 db.CreatePurchaseInvoiceTxParams) (db.CreatePurchaseInvoiceTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePurchaseInvoiceTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CreatePurchaseInvoiceTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePurchaseInvoiceTx indicates an expected call of CreatePurchaseInvoiceTx.
+func (mr *MockStoreMockRecorder) CreatePurchaseInvoiceTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePurchaseInvoiceTx", reflect.TypeOf((*MockStore)(nil).CreatePurchaseInvoiceTx), arg0, arg1)
+}
+
+// CreateSaleInvoice mocks base method.
+func (m *MockStore) CreateSaleInvoice(arg0 context.Context, arg1 db.CreateSaleInvoiceParams) (db.SaleInvoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSaleInvoice", arg0, arg1)
+	ret0, _ := ret[0].(db.SaleInvoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSaleInvoice indicates an expected call of CreateSaleInvoice.
+func (mr *MockStoreMockRecorder) CreateSaleInvoice(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSaleInvoice", reflect.TypeOf((*MockStore)(nil).CreateSaleInvoice), arg0, arg1)
+}
+
+// CreateSaleInvoicePartLines mocks base method.
+func (m *MockStore) CreateSaleInvoicePartLines(arg0 context.Context, arg1 db.CreateSaleInvoicePartLinesParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSaleInvoicePartLines", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSaleInvoicePartLines indicates an expected call of CreateSaleInvoicePartLines.
+func (mr *MockStoreMockRecorder) CreateSaleInvoicePartLines(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSaleInvoicePartLines", reflect.TypeOf((*MockStore)(nil).CreateSaleInvoicePartLines), arg0, arg1)
+}
+
+// CreateSaleInvoiceServiceLines mocks base method.
+func (m *MockStore) CreateSaleInvoiceServiceLines(arg0 context.Context, arg1 db.CreateSaleInvoiceServiceLinesParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSaleInvoiceServiceLines", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSaleInvoiceServiceLines indicates an expected call of CreateSaleInvoiceServiceLines.
+func (mr *MockStoreMockRecorder) CreateSaleInvoiceServiceLines(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSaleInvoiceServiceLines", reflect.TypeOf((*MockStore)(nil).CreateSaleInvoiceServiceLines), arg0, arg1)
+}
+
+// CreateSaleInvoiceTx mocks base method.
+func (m *MockStore) CreateSaleInvoiceTx(arg0 context.Context, arg1 int32) (db.CreateSaleInvoiceTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSaleInvoiceTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CreateSaleInvoiceTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSaleInvoiceTx indicates an expected call of CreateSaleInvoiceTx.
+func (mr *MockStoreMockRecorder) CreateSaleInvoiceTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSaleInvoiceTx", reflect.TypeOf((*MockStore)(nil).CreateSaleInvoiceTx), arg0, arg1)
+}
+
+// CreateService mocks base method.
+func (m *MockStore) CreateService(arg0 context.Context, arg1 db.CreateServiceParams) (db.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateService", arg0, arg1)
+	ret0, _ := ret[0].(db.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateService indicates an expected call of CreateService.
+func (mr *MockStoreMockRecorder) CreateService(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateService", reflect.TypeOf((*MockStore)(nil).CreateService), arg0, arg1)
+}
+
+// CreateServiceDetail mocks base method.
+func (m *MockStore) CreateServiceDetail(arg0 context.Context, arg1 db.CreateServiceDetailParams) (db.ServiceDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateServiceDetail", arg0, arg1)
+	ret0, _ := ret[0].(db.ServiceDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateServiceDetail indicates an expected call of CreateServiceDetail.
+func (mr *MockStoreMockRecorder) CreateServiceDetail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceDetail", reflect.TypeOf((*MockStore)(nil).CreateServiceDetail), arg0, arg1)
+}
+
+// CreateServiceOrder mocks base method.
+func (m *MockStore) CreateServiceOrder(arg0 context.Context, arg1 db.CreateServiceOrderParams) (db.ServiceOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateServiceOrder", arg0, arg1)
+	ret0, _ := ret[0].(db.ServiceOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateServiceOrder indicates an expected call of CreateServiceOrder.
+func (mr *MockStoreMockRecorder) CreateServiceOrder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceOrder", reflect.TypeOf((*MockStore)(nil).CreateServiceOrder), arg0, arg1)
+}
+
+// CreateSession mocks base method.
+func (m *MockStore) CreateSession(arg0 context.Context, arg1 db.CreateSessionParams) (db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", arg0, arg1)
+	ret0, _ := ret[0].(db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockStoreMockRecorder) CreateSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockStore)(nil).CreateSession), arg0, arg1)
+}
+
+// CreateSupplier mocks base method.
+func (m *MockStore) CreateSupplier(arg0 context.Context, arg1 db.CreateSupplierParams) (db.Supplier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSupplier", arg0, arg1)
+	ret0, _ := ret[0].(db.Supplier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSupplier indicates an expected call of CreateSupplier.
+func (mr *MockStoreMockRecorder) CreateSupplier(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSupplier", reflect.TypeOf((*MockStore)(nil).CreateSupplier), arg0, arg1)
+}
+
+// CreateUser mocks base method.
+func (m *MockStore) CreateUser(arg0 context.Context, arg1 db.CreateUserParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockStoreMockRecorder) CreateUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStore)(nil).CreateUser), arg0, arg1)
+}
+
+// DeleteCustomerTx mocks base method.
+func (m *MockStore) DeleteCustomerTx(arg0 context.Context, arg1 db.SoftDeleteCustomerParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCustomerTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCustomerTx indicates an expected call of DeleteCustomerTx.
+func (mr *MockStoreMockRecorder) DeleteCustomerTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomerTx", reflect.TypeOf((*MockStore)(nil).DeleteCustomerTx), arg0, arg1)
+}
+
+// DeleteMechanic mocks base method.
+func (m *MockStore) DeleteMechanic(arg0 context.Context, arg1 int32) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMechanic", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMechanic indicates an expected call of DeleteMechanic.
+func (mr *MockStoreMockRecorder) DeleteMechanic(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMechanic", reflect.TypeOf((*MockStore)(nil).DeleteMechanic), arg0, arg1)
+}
+
+// DeletePart mocks base method.
+func (m *MockStore) DeletePart(arg0 context.Context, arg1 int32) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePart", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePart indicates an expected call of DeletePart.
+func (mr *MockStoreMockRecorder) DeletePart(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePart", reflect.TypeOf((*MockStore)(nil).DeletePart), arg0, arg1)
+}
+
+// DeletePartDetail mocks base method.
+func (m *MockStore) DeletePartDetail(arg0 context.Context, arg1 db.DeletePartDetailParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePartDetail", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePartDetail indicates an expected call of DeletePartDetail.
+func (mr *MockStoreMockRecorder) DeletePartDetail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePartDetail", reflect.TypeOf((*MockStore)(nil).DeletePartDetail), arg0, arg1)
+}
+
+// DeletePartDetailTx mocks base method.
+func (m *MockStore) DeletePartDetailTx(arg0 context.Context, arg1 db.DeletePartDetailParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePartDetailTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePartDetailTx indicates an expected call of DeletePartDetailTx.
+func (mr *MockStoreMockRecorder) DeletePartDetailTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePartDetailTx", reflect.TypeOf((*MockStore)(nil).DeletePartDetailTx), arg0, arg1)
+}
+
+// DeletePayment mocks base method.
+func (m *MockStore) DeletePayment(arg0 context.Context, arg1 db.DeletePaymentParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePayment", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePayment indicates an expected call of DeletePayment.
+func (mr *MockStoreMockRecorder) DeletePayment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePayment", reflect.TypeOf((*MockStore)(nil).DeletePayment), arg0, arg1)
+}
+
+// DeletePurchaseDetail mocks base method.
+func (m *MockStore) DeletePurchaseDetail(arg0 context.Context, arg1 db.DeletePurchaseDetailParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePurchaseDetail", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePurchaseDetail indicates an expected call of DeletePurchaseDetail.
+func (mr *MockStoreMockRecorder) DeletePurchaseDetail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePurchaseDetail", reflect.TypeOf((*MockStore)(nil).DeletePurchaseDetail), arg0, arg1)
+}
+
+// DeletePurchaseDetailTx mocks base method.
+func (m *MockStore) DeletePurchaseDetailTx(arg0 context.Context, arg1 db.DeletePurchaseDetailParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePurchaseDetailTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePurchaseDetailTx indicates an expected call of DeletePurchaseDetailTx.
+func (mr *MockStoreMockRecorder) DeletePurchaseDetailTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePurchaseDetailTx", reflect.TypeOf((*MockStore)(nil).DeletePurchaseDetailTx), arg0, arg1)
+}
+
+// DeletePurchaseInvoice mocks base method.
+func (m *MockStore) DeletePurchaseInvoice(arg0 context.Context, arg1 int32) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePurchaseInvoice", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePurchaseInvoice indicates an expected call of DeletePurchaseInvoice.
+func (mr *MockStoreMockRecorder) DeletePurchaseInvoice(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePurchaseInvoice", reflect.TypeOf((*MockStore)(nil).DeletePurchaseInvoice), arg0, arg1)
+}
+
+// DeletePurchaseInvoiceTx mocks base method.
+func (m *MockStore) DeletePurchaseInvoiceTx(arg0 context.Context, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePurchaseInvoiceTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePurchaseInvoiceTx indicates an expected call of DeletePurchaseInvoiceTx.
+func (mr *MockStoreMockRecorder) DeletePurchaseInvoiceTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePurchaseInvoiceTx", reflect.TypeOf((*MockStore)(nil).DeletePurchaseInvoiceTx), arg0, arg1)
+}
+
+// DeleteService mocks base method.
+func (m *MockStore) DeleteService(arg0 context.Context, arg1 int32) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteService", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteService indicates an expected call of DeleteService.
+func (mr *MockStoreMockRecorder) DeleteService(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteService", reflect.TypeOf((*MockStore)(nil).DeleteService), arg0, arg1)
+}
+
+// DeleteServiceDetail mocks base method.
+func (m *MockStore) DeleteServiceDetail(arg0 context.Context, arg1 db.DeleteServiceDetailParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteServiceDetail", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteServiceDetail indicates an expected call of DeleteServiceDetail.
+func (mr *MockStoreMockRecorder) DeleteServiceDetail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceDetail", reflect.TypeOf((*MockStore)(nil).DeleteServiceDetail), arg0, arg1)
+}
+
+// DeleteServiceDetailTx mocks base method.
+func (m *MockStore) DeleteServiceDetailTx(arg0 context.Context, arg1 db.DeleteServiceDetailParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteServiceDetailTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteServiceDetailTx indicates an expected call of DeleteServiceDetailTx.
+func (mr *MockStoreMockRecorder) DeleteServiceDetailTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceDetailTx", reflect.TypeOf((*MockStore)(nil).DeleteServiceDetailTx), arg0, arg1)
+}
+
+// DeleteServiceOrder mocks base method.
+func (m *MockStore) DeleteServiceOrder(arg0 context.Context, arg1 db.DeleteServiceOrderParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteServiceOrder", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteServiceOrder indicates an expected call of DeleteServiceOrder.
+func (mr *MockStoreMockRecorder) DeleteServiceOrder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceOrder", reflect.TypeOf((*MockStore)(nil).DeleteServiceOrder), arg0, arg1)
+}
+
+// DeleteServiceOrderTx mocks base method.
+func (m *MockStore) DeleteServiceOrderTx(arg0 context.Context, arg1 db.DeleteServiceOrderParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteServiceOrderTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteServiceOrderTx indicates an expected call of DeleteServiceOrderTx.
+func (mr *MockStoreMockRecorder) DeleteServiceOrderTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceOrderTx", reflect.TypeOf((*MockStore)(nil).DeleteServiceOrderTx), arg0, arg1)
+}
+
+// DeleteSupplier mocks base method.
+func (m *MockStore) DeleteSupplier(arg0 context.Context, arg1 int32) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSupplier", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSupplier indicates an expected call of DeleteSupplier.
+func (mr *MockStoreMockRecorder) DeleteSupplier(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSupplier", reflect.TypeOf((*MockStore)(nil).DeleteSupplier), arg0, arg1)
+}
+
+// GetAppointment mocks base method.
+func (m *MockStore) GetAppointment(arg0 context.Context, arg1 int32) (db.Appointment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppointment", arg0, arg1)
+	ret0, _ := ret[0].(db.Appointment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppointment indicates an expected call of GetAppointment.
+func (mr *MockStoreMockRecorder) GetAppointment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppointment", reflect.TypeOf((*MockStore)(nil).GetAppointment), arg0, arg1)
+}
+
+// GetAppointmentForUpdate mocks base method.
+func (m *MockStore) GetAppointmentForUpdate(arg0 context.Context, arg1 int32) (db.Appointment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppointmentForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.Appointment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppointmentForUpdate indicates an expected call of GetAppointmentForUpdate.
+func (mr *MockStoreMockRecorder) GetAppointmentForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppointmentForUpdate", reflect.TypeOf((*MockStore)(nil).GetAppointmentForUpdate), arg0, arg1)
+}
+
+// GetCar mocks base method.
+func (m *MockStore) GetCar(arg0 context.Context, arg1 int32) (db.Car, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCar", arg0, arg1)
+	ret0, _ := ret[0].(db.Car)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCar indicates an expected call of GetCar.
+func (mr *MockStoreMockRecorder) GetCar(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCar", reflect.TypeOf((*MockStore)(nil).GetCar), arg0, arg1)
+}
+
+// GetCarWithDeleted mocks base method.
+func (m *MockStore) GetCarWithDeleted(arg0 context.Context, arg1 int32) (db.Car, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCarWithDeleted", arg0, arg1)
+	ret0, _ := ret[0].(db.Car)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCarWithDeleted indicates an expected call of GetCarWithDeleted.
+func (mr *MockStoreMockRecorder) GetCarWithDeleted(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCarWithDeleted", reflect.TypeOf((*MockStore)(nil).GetCarWithDeleted), arg0, arg1)
+}
+
+// GetClockedInLaborEntry mocks base method.
+func (m *MockStore) GetClockedInLaborEntry(arg0 context.Context, arg1 int32) (db.LaborEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClockedInLaborEntry", arg0, arg1)
+	ret0, _ := ret[0].(db.LaborEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClockedInLaborEntry indicates an expected call of GetClockedInLaborEntry.
+func (mr *MockStoreMockRecorder) GetClockedInLaborEntry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClockedInLaborEntry", reflect.TypeOf((*MockStore)(nil).GetClockedInLaborEntry), arg0, arg1)
+}
+
+// GetCustomer mocks base method.
+func (m *MockStore) GetCustomer(arg0 context.Context, arg1 int64) (db.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomer", arg0, arg1)
+	ret0, _ := ret[0].(db.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomer indicates an expected call of GetCustomer.
+func (mr *MockStoreMockRecorder) GetCustomer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomer", reflect.TypeOf((*MockStore)(nil).GetCustomer), arg0, arg1)
+}
+
+// GetCustomerForUpdate mocks base method.
+func (m *MockStore) GetCustomerForUpdate(arg0 context.Context, arg1 int64) (db.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomerForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomerForUpdate indicates an expected call of GetCustomerForUpdate.
+func (mr *MockStoreMockRecorder) GetCustomerForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerForUpdate", reflect.TypeOf((*MockStore)(nil).GetCustomerForUpdate), arg0, arg1)
+}
+
+// GetMechanic mocks base method.
+func (m *MockStore) GetMechanic(arg0 context.Context, arg1 int32) (db.Mechanic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMechanic", arg0, arg1)
+	ret0, _ := ret[0].(db.Mechanic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMechanic indicates an expected call of GetMechanic.
+func (mr *MockStoreMockRecorder) GetMechanic(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMechanic", reflect.TypeOf((*MockStore)(nil).GetMechanic), arg0, arg1)
+}
+
+// GetPart mocks base method.
+func (m *MockStore) GetPart(arg0 context.Context, arg1 int32) (db.Part, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPart", arg0, arg1)
+	ret0, _ := ret[0].(db.Part)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPart indicates an expected call of GetPart.
+func (mr *MockStoreMockRecorder) GetPart(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPart", reflect.TypeOf((*MockStore)(nil).GetPart), arg0, arg1)
+}
+
+// GetPartForUpdate mocks base method.
+func (m *MockStore) GetPartForUpdate(arg0 context.Context, arg1 int32) (db.Part, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPartForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.Part)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPartForUpdate indicates an expected call of GetPartForUpdate.
+func (mr *MockStoreMockRecorder) GetPartForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPartForUpdate", reflect.TypeOf((*MockStore)(nil).GetPartForUpdate), arg0, arg1)
+}
+
+// GetPartStock mocks base method.
+func (m *MockStore) GetPartStock(arg0 context.Context, arg1 int32) (db.PartStock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPartStock", arg0, arg1)
+	ret0, _ := ret[0].(db.PartStock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPartStock indicates an expected call of GetPartStock.
+func (mr *MockStoreMockRecorder) GetPartStock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPartStock", reflect.TypeOf((*MockStore)(nil).GetPartStock), arg0, arg1)
+}
+
+// GetPurchaseDetailForUpdate mocks base method.
+func (m *MockStore) GetPurchaseDetailForUpdate(arg0 context.Context, arg1 db.GetPurchaseDetailForUpdateParams) (db.PurchaseDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPurchaseDetailForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.PurchaseDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPurchaseDetailForUpdate indicates an expected call of GetPurchaseDetailForUpdate.
+func (mr *MockStoreMockRecorder) GetPurchaseDetailForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPurchaseDetailForUpdate", reflect.TypeOf((*MockStore)(nil).GetPurchaseDetailForUpdate), arg0, arg1)
+}
+
+// GetPurchaseInvoice mocks base method.
+func (m *MockStore) GetPurchaseInvoice(arg0 context.Context, arg1 int32) (db.PurchaseInvoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPurchaseInvoice", arg0, arg1)
+	ret0, _ := ret[0].(db.PurchaseInvoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPurchaseInvoice indicates an expected call of GetPurchaseInvoice.
+func (mr *MockStoreMockRecorder) GetPurchaseInvoice(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPurchaseInvoice", reflect.TypeOf((*MockStore)(nil).GetPurchaseInvoice), arg0, arg1)
+}
+
+// GetPurchaseInvoiceForUpdate mocks base method.
+func (m *MockStore) GetPurchaseInvoiceForUpdate(arg0 context.Context, arg1 int32) (db.PurchaseInvoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPurchaseInvoiceForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.PurchaseInvoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPurchaseInvoiceForUpdate indicates an expected call of GetPurchaseInvoiceForUpdate.
+func (mr *MockStoreMockRecorder) GetPurchaseInvoiceForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPurchaseInvoiceForUpdate", reflect.TypeOf((*MockStore)(nil).GetPurchaseInvoiceForUpdate), arg0, arg1)
+}
+
+// GetSaleInvoice mocks base method.
+func (m *MockStore) GetSaleInvoice(arg0 context.Context, arg1 int32) (db.SaleInvoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSaleInvoice", arg0, arg1)
+	ret0, _ := ret[0].(db.SaleInvoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSaleInvoice indicates an expected call of GetSaleInvoice.
+func (mr *MockStoreMockRecorder) GetSaleInvoice(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSaleInvoice", reflect.TypeOf((*MockStore)(nil).GetSaleInvoice), arg0, arg1)
+}
+
+// GetSaleInvoiceBalance mocks base method.
+func (m *MockStore) GetSaleInvoiceBalance(arg0 context.Context, arg1 int32) (db.SaleInvoiceBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSaleInvoiceBalance", arg0, arg1)
+	ret0, _ := ret[0].(db.SaleInvoiceBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSaleInvoiceBalance indicates an expected call of GetSaleInvoiceBalance.
+func (mr *MockStoreMockRecorder) GetSaleInvoiceBalance(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSaleInvoiceBalance", reflect.TypeOf((*MockStore)(nil).GetSaleInvoiceBalance), arg0, arg1)
+}
+
+// GetSaleInvoiceByServiceOrder mocks base method.
+func (m *MockStore) GetSaleInvoiceByServiceOrder(arg0 context.Context, arg1 int32) (db.SaleInvoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSaleInvoiceByServiceOrder", arg0, arg1)
+	ret0, _ := ret[0].(db.SaleInvoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSaleInvoiceByServiceOrder indicates an expected call of GetSaleInvoiceByServiceOrder.
+func (mr *MockStoreMockRecorder) GetSaleInvoiceByServiceOrder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSaleInvoiceByServiceOrder", reflect.TypeOf((*MockStore)(nil).GetSaleInvoiceByServiceOrder), arg0, arg1)
+}
+
+// GetService mocks base method.
+func (m *MockStore) GetService(arg0 context.Context, arg1 int32) (db.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetService", arg0, arg1)
+	ret0, _ := ret[0].(db.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetService indicates an expected call of GetService.
+func (mr *MockStoreMockRecorder) GetService(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetService", reflect.TypeOf((*MockStore)(nil).GetService), arg0, arg1)
+}
+
+// GetServiceDetailForUpdate mocks base method.
+func (m *MockStore) GetServiceDetailForUpdate(arg0 context.Context, arg1 db.GetServiceDetailForUpdateParams) (db.ServiceDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceDetailForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.ServiceDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceDetailForUpdate indicates an expected call of GetServiceDetailForUpdate.
+func (mr *MockStoreMockRecorder) GetServiceDetailForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceDetailForUpdate", reflect.TypeOf((*MockStore)(nil).GetServiceDetailForUpdate), arg0, arg1)
+}
+
+// GetServiceOrder mocks base method.
+func (m *MockStore) GetServiceOrder(arg0 context.Context, arg1 int32) (db.ServiceOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceOrder", arg0, arg1)
+	ret0, _ := ret[0].(db.ServiceOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceOrder indicates an expected call of GetServiceOrder.
+func (mr *MockStoreMockRecorder) GetServiceOrder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceOrder", reflect.TypeOf((*MockStore)(nil).GetServiceOrder), arg0, arg1)
+}
+
+// GetServiceOrderForUpdate mocks base method.
+func (m *MockStore) GetServiceOrderForUpdate(arg0 context.Context, arg1 int32) (db.ServiceOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceOrderForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.ServiceOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceOrderForUpdate indicates an expected call of GetServiceOrderForUpdate.
+func (mr *MockStoreMockRecorder) GetServiceOrderForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceOrderForUpdate", reflect.TypeOf((*MockStore)(nil).GetServiceOrderForUpdate), arg0, arg1)
+}
+
+// GetSession mocks base method.
+func (m *MockStore) GetSession(arg0 context.Context, arg1 uuid.UUID) (db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", arg0, arg1)
+	ret0, _ := ret[0].(db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockStoreMockRecorder) GetSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockStore)(nil).GetSession), arg0, arg1)
+}
+
+// GetSupplier mocks base method.
+func (m *MockStore) GetSupplier(arg0 context.Context, arg1 int32) (db.Supplier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupplier", arg0, arg1)
+	ret0, _ := ret[0].(db.Supplier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSupplier indicates an expected call of GetSupplier.
+func (mr *MockStoreMockRecorder) GetSupplier(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupplier", reflect.TypeOf((*MockStore)(nil).GetSupplier), arg0, arg1)
+}
+
+// GetUser mocks base method.
+func (m *MockStore) GetUser(arg0 context.Context, arg1 string) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockStoreMockRecorder) GetUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), arg0, arg1)
+}
+
+// ListAppointmentServices mocks base method.
+func (m *MockStore) ListAppointmentServices(arg0 context.Context, arg1 int32) ([]db.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAppointmentServices", arg0, arg1)
+	ret0, _ := ret[0].([]db.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAppointmentServices indicates an expected call of ListAppointmentServices.
+func (mr *MockStoreMockRecorder) ListAppointmentServices(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAppointmentServices", reflect.TypeOf((*MockStore)(nil).ListAppointmentServices), arg0, arg1)
+}
+
+// ListAppointmentServicesBetween mocks base method.
+func (m *MockStore) ListAppointmentServicesBetween(arg0 context.Context, arg1 db.ListAppointmentServicesBetweenParams) ([]db.ListAppointmentServicesBetweenRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAppointmentServicesBetween", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListAppointmentServicesBetweenRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAppointmentServicesBetween indicates an expected call of ListAppointmentServicesBetween.
+func (mr *MockStoreMockRecorder) ListAppointmentServicesBetween(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAppointmentServicesBetween", reflect.TypeOf((*MockStore)(nil).ListAppointmentServicesBetween), arg0, arg1)
+}
+
+// ListAppointmentsBetween mocks base method.
+func (m *MockStore) ListAppointmentsBetween(arg0 context.Context, arg1 db.ListAppointmentsBetweenParams) ([]db.Appointment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAppointmentsBetween", arg0, arg1)
+	ret0, _ := ret[0].([]db.Appointment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAppointmentsBetween indicates an expected call of ListAppointmentsBetween.
+func (mr *MockStoreMockRecorder) ListAppointmentsBetween(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAppointmentsBetween", reflect.TypeOf((*MockStore)(nil).ListAppointmentsBetween), arg0, arg1)
+}
+
+// ListCarMechanics mocks base method.
+func (m *MockStore) ListCarMechanics(arg0 context.Context, arg1 int32) ([]db.ListCarMechanicsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCarMechanics", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListCarMechanicsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCarMechanics indicates an expected call of ListCarMechanics.
+func (mr *MockStoreMockRecorder) ListCarMechanics(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCarMechanics", reflect.TypeOf((*MockStore)(nil).ListCarMechanics), arg0, arg1)
+}
+
+// ListCarPartDetails mocks base method.
+func (m *MockStore) ListCarPartDetails(arg0 context.Context, arg1 int32) ([]db.ListCarPartDetailsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCarPartDetails", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListCarPartDetailsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCarPartDetails indicates an expected call of ListCarPartDetails.
+func (mr *MockStoreMockRecorder) ListCarPartDetails(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCarPartDetails", reflect.TypeOf((*MockStore)(nil).ListCarPartDetails), arg0, arg1)
+}
+
+// ListCarSaleInvoices mocks base method.
+func (m *MockStore) ListCarSaleInvoices(arg0 context.Context, arg1 int32) ([]db.SaleInvoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCarSaleInvoices", arg0, arg1)
+	ret0, _ := ret[0].([]db.SaleInvoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCarSaleInvoices indicates an expected call of ListCarSaleInvoices.
+func (mr *MockStoreMockRecorder) ListCarSaleInvoices(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCarSaleInvoices", reflect.TypeOf((*MockStore)(nil).ListCarSaleInvoices), arg0, arg1)
+}
+
+// ListCarServiceDetails mocks base method.
+func (m *MockStore) ListCarServiceDetails(arg0 context.Context, arg1 int32) ([]db.ListCarServiceDetailsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCarServiceDetails", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListCarServiceDetailsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCarServiceDetails indicates an expected call of ListCarServiceDetails.
+func (mr *MockStoreMockRecorder) ListCarServiceDetails(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCarServiceDetails", reflect.TypeOf((*MockStore)(nil).ListCarServiceDetails), arg0, arg1)
+}
+
+// ListCarServiceOrders mocks base method.
+func (m *MockStore) ListCarServiceOrders(arg0 context.Context, arg1 int32) ([]db.ServiceOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCarServiceOrders", arg0, arg1)
+	ret0, _ := ret[0].([]db.ServiceOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCarServiceOrders indicates an expected call of ListCarServiceOrders.
+func (mr *MockStoreMockRecorder) ListCarServiceOrders(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCarServiceOrders", reflect.TypeOf((*MockStore)(nil).ListCarServiceOrders), arg0, arg1)
+}
+
+// ListCarsByCustomer mocks base method.
+func (m *MockStore) ListCarsByCustomer(arg0 context.Context, arg1 int32) ([]db.Car, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCarsByCustomer", arg0, arg1)
+	ret0, _ := ret[0].([]db.Car)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCarsByCustomer indicates an expected call of ListCarsByCustomer.
+func (mr *MockStoreMockRecorder) ListCarsByCustomer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCarsByCustomer", reflect.TypeOf((*MockStore)(nil).ListCarsByCustomer), arg0, arg1)
+}
+
+// ListCarsWhere mocks base method.
+func (m *MockStore) ListCarsWhere(arg0 context.Context, arg1 db.ListParams) ([]db.Car, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCarsWhere", arg0, arg1)
+	ret0, _ := ret[0].([]db.Car)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCarsWhere indicates an expected call of ListCarsWhere.
+func (mr *MockStoreMockRecorder) ListCarsWhere(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCarsWhere", reflect.TypeOf((*MockStore)(nil).ListCarsWhere), arg0, arg1)
+}
+
+// ListCustomers mocks base method.
+func (m *MockStore) ListCustomers(arg0 context.Context, arg1 db.ListCustomersParams) ([]db.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomers", arg0, arg1)
+	ret0, _ := ret[0].([]db.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomers indicates an expected call of ListCustomers.
+func (mr *MockStoreMockRecorder) ListCustomers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomers", reflect.TypeOf((*MockStore)(nil).ListCustomers), arg0, arg1)
+}
+
+// ListCustomersWhere mocks base method.
+func (m *MockStore) ListCustomersWhere(arg0 context.Context, arg1 db.ListParams) ([]db.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomersWhere", arg0, arg1)
+	ret0, _ := ret[0].([]db.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomersWhere indicates an expected call of ListCustomersWhere.
+func (mr *MockStoreMockRecorder) ListCustomersWhere(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomersWhere", reflect.TypeOf((*MockStore)(nil).ListCustomersWhere), arg0, arg1)
+}
+
+// ListInvoicePayments mocks base method.
+func (m *MockStore) ListInvoicePayments(arg0 context.Context, arg1 int32) ([]db.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInvoicePayments", arg0, arg1)
+	ret0, _ := ret[0].([]db.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInvoicePayments indicates an expected call of ListInvoicePayments.
+func (mr *MockStoreMockRecorder) ListInvoicePayments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInvoicePayments", reflect.TypeOf((*MockStore)(nil).ListInvoicePayments), arg0, arg1)
+}
+
+// ListLowStockParts mocks base method.
+func (m *MockStore) ListLowStockParts(arg0 context.Context) ([]db.PartStock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLowStockParts", arg0)
+	ret0, _ := ret[0].([]db.PartStock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLowStockParts indicates an expected call of ListLowStockParts.
+func (mr *MockStoreMockRecorder) ListLowStockParts(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLowStockParts", reflect.TypeOf((*MockStore)(nil).ListLowStockParts), arg0)
+}
+
+// ListMechanicWorkload mocks base method.
+func (m *MockStore) ListMechanicWorkload(arg0 context.Context, arg1 db.ListMechanicWorkloadParams) ([]db.ServiceOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMechanicWorkload", arg0, arg1)
+	ret0, _ := ret[0].([]db.ServiceOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMechanicWorkload indicates an expected call of ListMechanicWorkload.
+func (mr *MockStoreMockRecorder) ListMechanicWorkload(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMechanicWorkload", reflect.TypeOf((*MockStore)(nil).ListMechanicWorkload), arg0, arg1)
+}
+
+// ListMechanics mocks base method.
+func (m *MockStore) ListMechanics(arg0 context.Context, arg1 db.ListMechanicsParams) ([]db.Mechanic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMechanics", arg0, arg1)
+	ret0, _ := ret[0].([]db.Mechanic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMechanics indicates an expected call of ListMechanics.
+func (mr *MockStoreMockRecorder) ListMechanics(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMechanics", reflect.TypeOf((*MockStore)(nil).ListMechanics), arg0, arg1)
+}
+
+// ListOutstandingInvoiceAging mocks base method.
+func (m *MockStore) ListOutstandingInvoiceAging(arg0 context.Context) ([]db.ListOutstandingInvoiceAgingRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOutstandingInvoiceAging", arg0)
+	ret0, _ := ret[0].([]db.ListOutstandingInvoiceAgingRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOutstandingInvoiceAging indicates an expected call of ListOutstandingInvoiceAging.
+func (mr *MockStoreMockRecorder) ListOutstandingInvoiceAging(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOutstandingInvoiceAging", reflect.TypeOf((*MockStore)(nil).ListOutstandingInvoiceAging), arg0)
+}
+
+// ListOutstandingInvoices mocks base method.
+func (m *MockStore) ListOutstandingInvoices(arg0 context.Context) ([]db.SaleInvoiceBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOutstandingInvoices", arg0)
+	ret0, _ := ret[0].([]db.SaleInvoiceBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOutstandingInvoices indicates an expected call of ListOutstandingInvoices.
+func (mr *MockStoreMockRecorder) ListOutstandingInvoices(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOutstandingInvoices", reflect.TypeOf((*MockStore)(nil).ListOutstandingInvoices), arg0)
+}
+
+// ListPartsWhere mocks base method.
+func (m *MockStore) ListPartsWhere(arg0 context.Context, arg1 db.ListParams) ([]db.Part, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPartsWhere", arg0, arg1)
+	ret0, _ := ret[0].([]db.Part)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPartsWhere indicates an expected call of ListPartsWhere.
+func (mr *MockStoreMockRecorder) ListPartsWhere(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPartsWhere", reflect.TypeOf((*MockStore)(nil).ListPartsWhere), arg0, arg1)
+}
+
+// ListPurchaseDetails mocks base method.
+func (m *MockStore) ListPurchaseDetails(arg0 context.Context, arg1 int32) ([]db.PurchaseDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPurchaseDetails", arg0, arg1)
+	ret0, _ := ret[0].([]db.PurchaseDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPurchaseDetails indicates an expected call of ListPurchaseDetails.
+func (mr *MockStoreMockRecorder) ListPurchaseDetails(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPurchaseDetails", reflect.TypeOf((*MockStore)(nil).ListPurchaseDetails), arg0, arg1)
+}
+
+// ListPurchaseInvoices mocks base method.
+func (m *MockStore) ListPurchaseInvoices(arg0 context.Context, arg1 db.ListPurchaseInvoicesParams) ([]db.PurchaseInvoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPurchaseInvoices", arg0, arg1)
+	ret0, _ := ret[0].([]db.PurchaseInvoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPurchaseInvoices indicates an expected call of ListPurchaseInvoices.
+func (mr *MockStoreMockRecorder) ListPurchaseInvoices(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPurchaseInvoices", reflect.TypeOf((*MockStore)(nil).ListPurchaseInvoices), arg0, arg1)
+}
+
+// ListSaleInvoiceLines mocks base method.
+func (m *MockStore) ListSaleInvoiceLines(arg0 context.Context, arg1 int32) ([]db.SaleInvoiceLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSaleInvoiceLines", arg0, arg1)
+	ret0, _ := ret[0].([]db.SaleInvoiceLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSaleInvoiceLines indicates an expected call of ListSaleInvoiceLines.
+func (mr *MockStoreMockRecorder) ListSaleInvoiceLines(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSaleInvoiceLines", reflect.TypeOf((*MockStore)(nil).ListSaleInvoiceLines), arg0, arg1)
+}
+
+// ListSaleInvoices mocks base method.
+func (m *MockStore) ListSaleInvoices(arg0 context.Context, arg1 db.ListSaleInvoicesParams) ([]db.SaleInvoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSaleInvoices", arg0, arg1)
+	ret0, _ := ret[0].([]db.SaleInvoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSaleInvoices indicates an expected call of ListSaleInvoices.
+func (mr *MockStoreMockRecorder) ListSaleInvoices(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSaleInvoices", reflect.TypeOf((*MockStore)(nil).ListSaleInvoices), arg0, arg1)
+}
+
+// ListServiceLaborReport mocks base method.
+func (m *MockStore) ListServiceLaborReport(arg0 context.Context, arg1 db.ListServiceLaborReportParams) ([]db.ListServiceLaborReportRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServiceLaborReport", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListServiceLaborReportRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServiceLaborReport indicates an expected call of ListServiceLaborReport.
+func (mr *MockStoreMockRecorder) ListServiceLaborReport(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceLaborReport", reflect.TypeOf((*MockStore)(nil).ListServiceLaborReport), arg0, arg1)
+}
+
+// ListServiceOrderLaborEntries mocks base method.
+func (m *MockStore) ListServiceOrderLaborEntries(arg0 context.Context, arg1 int32) ([]db.LaborEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServiceOrderLaborEntries", arg0, arg1)
+	ret0, _ := ret[0].([]db.LaborEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServiceOrderLaborEntries indicates an expected call of ListServiceOrderLaborEntries.
+func (mr *MockStoreMockRecorder) ListServiceOrderLaborEntries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceOrderLaborEntries", reflect.TypeOf((*MockStore)(nil).ListServiceOrderLaborEntries), arg0, arg1)
+}
+
+// ListServiceOrderMechanics mocks base method.
+func (m *MockStore) ListServiceOrderMechanics(arg0 context.Context, arg1 int32) ([]db.Mechanic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServiceOrderMechanics", arg0, arg1)
+	ret0, _ := ret[0].([]db.Mechanic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServiceOrderMechanics indicates an expected call of ListServiceOrderMechanics.
+func (mr *MockStoreMockRecorder) ListServiceOrderMechanics(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceOrderMechanics", reflect.TypeOf((*MockStore)(nil).ListServiceOrderMechanics), arg0, arg1)
+}
+
+// ListServiceOrderParts mocks base method.
+func (m *MockStore) ListServiceOrderParts(arg0 context.Context, arg1 int32) ([]db.PartDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServiceOrderParts", arg0, arg1)
+	ret0, _ := ret[0].([]db.PartDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServiceOrderParts indicates an expected call of ListServiceOrderParts.
+func (mr *MockStoreMockRecorder) ListServiceOrderParts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceOrderParts", reflect.TypeOf((*MockStore)(nil).ListServiceOrderParts), arg0, arg1)
+}
+
+// ListServiceOrderServices mocks base method.
+func (m *MockStore) ListServiceOrderServices(arg0 context.Context, arg1 int32) ([]db.ServiceDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServiceOrderServices", arg0, arg1)
+	ret0, _ := ret[0].([]db.ServiceDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServiceOrderServices indicates an expected call of ListServiceOrderServices.
+func (mr *MockStoreMockRecorder) ListServiceOrderServices(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceOrderServices", reflect.TypeOf((*MockStore)(nil).ListServiceOrderServices), arg0, arg1)
+}
+
+// ListServiceOrdersWhere mocks base method.
+func (m *MockStore) ListServiceOrdersWhere(arg0 context.Context, arg1 db.ListParams) ([]db.ServiceOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServiceOrdersWhere", arg0, arg1)
+	ret0, _ := ret[0].([]db.ServiceOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServiceOrdersWhere indicates an expected call of ListServiceOrdersWhere.
+func (mr *MockStoreMockRecorder) ListServiceOrdersWhere(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceOrdersWhere", reflect.TypeOf((*MockStore)(nil).ListServiceOrdersWhere), arg0, arg1)
+}
+
+// ListServices mocks base method.
+func (m *MockStore) ListServices(arg0 context.Context, arg1 db.ListServicesParams) ([]db.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServices", arg0, arg1)
+	ret0, _ := ret[0].([]db.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServices indicates an expected call of ListServices.
+func (mr *MockStoreMockRecorder) ListServices(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServices", reflect.TypeOf((*MockStore)(nil).ListServices), arg0, arg1)
+}
+
+// ListSuppliers mocks base method.
+func (m *MockStore) ListSuppliers(arg0 context.Context, arg1 db.ListSuppliersParams) ([]db.Supplier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSuppliers", arg0, arg1)
+	ret0, _ := ret[0].([]db.Supplier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSuppliers indicates an expected call of ListSuppliers.
+func (mr *MockStoreMockRecorder) ListSuppliers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSuppliers", reflect.TypeOf((*MockStore)(nil).ListSuppliers), arg0, arg1)
+}
+
+// ListUsers mocks base method.
+func (m *MockStore) ListUsers(arg0 context.Context, arg1 db.ListUsersParams) ([]db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", arg0, arg1)
+	ret0, _ := ret[0].([]db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockStoreMockRecorder) ListUsers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockStore)(nil).ListUsers), arg0, arg1)
+}
+
+// LockAppointments mocks base method.
+func (m *MockStore) LockAppointments(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockAppointments", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockAppointments indicates an expected call of LockAppointments.
+func (mr *MockStoreMockRecorder) LockAppointments(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAppointments", reflect.TypeOf((*MockStore)(nil).LockAppointments), arg0)
+}
+
+// LockCustomerServiceOrders mocks base method.
+func (m *MockStore) LockCustomerServiceOrders(arg0 context.Context, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockCustomerServiceOrders", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockCustomerServiceOrders indicates an expected call of LockCustomerServiceOrders.
+func (mr *MockStoreMockRecorder) LockCustomerServiceOrders(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockCustomerServiceOrders", reflect.TypeOf((*MockStore)(nil).LockCustomerServiceOrders), arg0, arg1)
+}
+
+// LockUsers mocks base method.
+func (m *MockStore) LockUsers(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockUsers", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockUsers indicates an expected call of LockUsers.
+func (mr *MockStoreMockRecorder) LockUsers(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockUsers", reflect.TypeOf((*MockStore)(nil).LockUsers), arg0)
+}
+
+// NextSaleInvoiceRef mocks base method.
+func (m *MockStore) NextSaleInvoiceRef(arg0 context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextSaleInvoiceRef", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NextSaleInvoiceRef indicates an expected call of NextSaleInvoiceRef.
+func (mr *MockStoreMockRecorder) NextSaleInvoiceRef(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextSaleInvoiceRef", reflect.TypeOf((*MockStore)(nil).NextSaleInvoiceRef), arg0)
+}
+
+// PatchCustomer mocks base method.
+func (m *MockStore) PatchCustomer(arg0 context.Context, arg1 db.PatchCustomerParams) (db.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchCustomer", arg0, arg1)
+	ret0, _ := ret[0].(db.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchCustomer indicates an expected call of PatchCustomer.
+func (mr *MockStoreMockRecorder) PatchCustomer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchCustomer", reflect.TypeOf((*MockStore)(nil).PatchCustomer), arg0, arg1)
+}
+
+// PatchServiceOrder mocks base method.
+func (m *MockStore) PatchServiceOrder(arg0 context.Context, arg1 db.PatchServiceOrderParams) (db.ServiceOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchServiceOrder", arg0, arg1)
+	ret0, _ := ret[0].(db.ServiceOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchServiceOrder indicates an expected call of PatchServiceOrder.
+func (mr *MockStoreMockRecorder) PatchServiceOrder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchServiceOrder", reflect.TypeOf((*MockStore)(nil).PatchServiceOrder), arg0, arg1)
+}
+
+// PurgeCustomer mocks base method.
+func (m *MockStore) PurgeCustomer(arg0 context.Context, arg1 int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeCustomer", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeCustomer indicates an expected call of PurgeCustomer.
+func (mr *MockStoreMockRecorder) PurgeCustomer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeCustomer", reflect.TypeOf((*MockStore)(nil).PurgeCustomer), arg0, arg1)
+}
+
+// PurgeCustomerTx mocks base method.
+func (m *MockStore) PurgeCustomerTx(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeCustomerTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeCustomerTx indicates an expected call of PurgeCustomerTx.
+func (mr *MockStoreMockRecorder) PurgeCustomerTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeCustomerTx", reflect.TypeOf((*MockStore)(nil).PurgeCustomerTx), arg0, arg1)
+}
+
+// RestoreCar mocks base method.
+func (m *MockStore) RestoreCar(arg0 context.Context, arg1 int32) (db.Car, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreCar", arg0, arg1)
+	ret0, _ := ret[0].(db.Car)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreCar indicates an expected call of RestoreCar.
+func (mr *MockStoreMockRecorder) RestoreCar(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreCar", reflect.TypeOf((*MockStore)(nil).RestoreCar), arg0, arg1)
+}
+
+// RestoreCarTx mocks base method.
+func (m *MockStore) RestoreCarTx(arg0 context.Context, arg1 int32) (db.Car, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreCarTx", arg0, arg1)
+	ret0, _ := ret[0].(db.Car)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreCarTx indicates an expected call of RestoreCarTx.
+func (mr *MockStoreMockRecorder) RestoreCarTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreCarTx", reflect.TypeOf((*MockStore)(nil).RestoreCarTx), arg0, arg1)
+}
+
+// RestoreCustomer mocks base method.
+func (m *MockStore) RestoreCustomer(arg0 context.Context, arg1 int64) (db.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreCustomer", arg0, arg1)
+	ret0, _ := ret[0].(db.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreCustomer indicates an expected call of RestoreCustomer.
+func (mr *MockStoreMockRecorder) RestoreCustomer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreCustomer", reflect.TypeOf((*MockStore)(nil).RestoreCustomer), arg0, arg1)
+}
+
+// RestoreCustomerCars mocks base method.
+func (m *MockStore) RestoreCustomerCars(arg0 context.Context, arg1 db.RestoreCustomerCarsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreCustomerCars", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreCustomerCars indicates an expected call of RestoreCustomerCars.
+func (mr *MockStoreMockRecorder) RestoreCustomerCars(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreCustomerCars", reflect.TypeOf((*MockStore)(nil).RestoreCustomerCars), arg0, arg1)
+}
+
+// RestoreCustomerTx mocks base method.
+func (m *MockStore) RestoreCustomerTx(arg0 context.Context, arg1 int64) (db.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreCustomerTx", arg0, arg1)
+	ret0, _ := ret[0].(db.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreCustomerTx indicates an expected call of RestoreCustomerTx.
+func (mr *MockStoreMockRecorder) RestoreCustomerTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreCustomerTx", reflect.TypeOf((*MockStore)(nil).RestoreCustomerTx), arg0, arg1)
+}
+
+// SearchCustomers mocks base method.
+func (m *MockStore) SearchCustomers(arg0 context.Context, arg1 db.SearchCustomersParams) ([]db.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchCustomers", arg0, arg1)
+	ret0, _ := ret[0].([]db.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchCustomers indicates an expected call of SearchCustomers.
+func (mr *MockStoreMockRecorder) SearchCustomers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCustomers", reflect.TypeOf((*MockStore)(nil).SearchCustomers), arg0, arg1)
+}
+
+// SoftDeleteCar mocks base method.
+func (m *MockStore) SoftDeleteCar(arg0 context.Context, arg1 db.SoftDeleteCarParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDeleteCar", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SoftDeleteCar indicates an expected call of SoftDeleteCar.
+func (mr *MockStoreMockRecorder) SoftDeleteCar(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteCar", reflect.TypeOf((*MockStore)(nil).SoftDeleteCar), arg0, arg1)
+}
+
+// SoftDeleteCustomer mocks base method.
+func (m *MockStore) SoftDeleteCustomer(arg0 context.Context, arg1 db.SoftDeleteCustomerParams) (db.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDeleteCustomer", arg0, arg1)
+	ret0, _ := ret[0].(db.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SoftDeleteCustomer indicates an expected call of SoftDeleteCustomer.
+func (mr *MockStoreMockRecorder) SoftDeleteCustomer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteCustomer", reflect.TypeOf((*MockStore)(nil).SoftDeleteCustomer), arg0, arg1)
+}
+
+// SoftDeleteCustomerCars mocks base method.
+func (m *MockStore) SoftDeleteCustomerCars(arg0 context.Context, arg1 db.SoftDeleteCustomerCarsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDeleteCustomerCars", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SoftDeleteCustomerCars indicates an expected call of SoftDeleteCustomerCars.
+func (mr *MockStoreMockRecorder) SoftDeleteCustomerCars(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteCustomerCars", reflect.TypeOf((*MockStore)(nil).SoftDeleteCustomerCars), arg0, arg1)
+}
+
+// TransitionServiceDetailTx mocks base method.
+func (m *MockStore) TransitionServiceDetailTx(arg0 context.Context, arg1 db.TransitionServiceDetailTxParams) (db.TransitionServiceDetailTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransitionServiceDetailTx", arg0, arg1)
+	ret0, _ := ret[0].(db.TransitionServiceDetailTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransitionServiceDetailTx indicates an expected call of TransitionServiceDetailTx.
+func (mr *MockStoreMockRecorder) TransitionServiceDetailTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitionServiceDetailTx", reflect.TypeOf((*MockStore)(nil).TransitionServiceDetailTx), arg0, arg1)
+}
+
+// TransitionServiceOrderTx mocks base method.
+func (m *MockStore) TransitionServiceOrderTx(arg0 context.Context, arg1 db.TransitionServiceOrderTxParams) (db.ServiceOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransitionServiceOrderTx", arg0, arg1)
+	ret0, _ := ret[0].(db.ServiceOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransitionServiceOrderTx indicates an expected call of TransitionServiceOrderTx.
+func (mr *MockStoreMockRecorder) TransitionServiceOrderTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitionServiceOrderTx", reflect.TypeOf((*MockStore)(nil).TransitionServiceOrderTx), arg0, arg1)
+}
+
+// UnassignMechanic mocks base method.
+func (m *MockStore) UnassignMechanic(arg0 context.Context, arg1 db.UnassignMechanicParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnassignMechanic", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnassignMechanic indicates an expected call of UnassignMechanic.
+func (mr *MockStoreMockRecorder) UnassignMechanic(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassignMechanic", reflect.TypeOf((*MockStore)(nil).UnassignMechanic), arg0, arg1)
+}
+
+// UpdateAppointmentState mocks base method.
+func (m *MockStore) UpdateAppointmentState(arg0 context.Context, arg1 db.UpdateAppointmentStateParams) (db.Appointment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAppointmentState", arg0, arg1)
+	ret0, _ := ret[0].(db.Appointment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAppointmentState indicates an expected call of UpdateAppointmentState.
+func (mr *MockStoreMockRecorder) UpdateAppointmentState(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAppointmentState", reflect.TypeOf((*MockStore)(nil).UpdateAppointmentState), arg0, arg1)
+}
+
+// UpdateCar mocks base method.
+func (m *MockStore) UpdateCar(arg0 context.Context, arg1 db.UpdateCarParams) (db.Car, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCar", arg0, arg1)
+	ret0, _ := ret[0].(db.Car)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCar indicates an expected call of UpdateCar.
+func (mr *MockStoreMockRecorder) UpdateCar(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCar", reflect.TypeOf((*MockStore)(nil).UpdateCar), arg0, arg1)
+}
+
+// UpdateCustomer mocks base method.
+func (m *MockStore) UpdateCustomer(arg0 context.Context, arg1 db.UpdateCustomerParams) (db.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomer", arg0, arg1)
+	ret0, _ := ret[0].(db.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCustomer indicates an expected call of UpdateCustomer.
+func (mr *MockStoreMockRecorder) UpdateCustomer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomer", reflect.TypeOf((*MockStore)(nil).UpdateCustomer), arg0, arg1)
+}
+
+// UpdateMechanic mocks base method.
+func (m *MockStore) UpdateMechanic(arg0 context.Context, arg1 db.UpdateMechanicParams) (db.Mechanic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMechanic", arg0, arg1)
+	ret0, _ := ret[0].(db.Mechanic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMechanic indicates an expected call of UpdateMechanic.
+func (mr *MockStoreMockRecorder) UpdateMechanic(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMechanic", reflect.TypeOf((*MockStore)(nil).UpdateMechanic), arg0, arg1)
+}
+
+// UpdatePart mocks base method.
+func (m *MockStore) UpdatePart(arg0 context.Context, arg1 db.UpdatePartParams) (db.Part, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePart", arg0, arg1)
+	ret0, _ := ret[0].(db.Part)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePart indicates an expected call of UpdatePart.
+func (mr *MockStoreMockRecorder) UpdatePart(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePart", reflect.TypeOf((*MockStore)(nil).UpdatePart), arg0, arg1)
+}
+
+// UpdatePurchaseDetail mocks base method.
+func (m *MockStore) UpdatePurchaseDetail(arg0 context.Context, arg1 db.UpdatePurchaseDetailParams) (db.PurchaseDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePurchaseDetail", arg0, arg1)
+	ret0, _ := ret[0].(db.PurchaseDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePurchaseDetail indicates an expected call of UpdatePurchaseDetail.
+func (mr *MockStoreMockRecorder) UpdatePurchaseDetail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePurchaseDetail", reflect.TypeOf((*MockStore)(nil).UpdatePurchaseDetail), arg0, arg1)
+}
+
+// UpdatePurchaseDetailTx mocks base method.
+func (m *MockStore) UpdatePurchaseDetailTx(arg0 context.Context, arg1 db.UpdatePurchaseDetailParams) (db.PurchaseDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePurchaseDetailTx", arg0, arg1)
+	ret0, _ := ret[0].(db.PurchaseDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePurchaseDetailTx indicates an expected call of UpdatePurchaseDetailTx.
+func (mr *MockStoreMockRecorder) UpdatePurchaseDetailTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePurchaseDetailTx", reflect.TypeOf((*MockStore)(nil).UpdatePurchaseDetailTx), arg0, arg1)
+}
+
+// UpdatePurchaseInvoice mocks base method.
+func (m *MockStore) UpdatePurchaseInvoice(arg0 context.Context, arg1 db.UpdatePurchaseInvoiceParams) (db.PurchaseInvoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePurchaseInvoice", arg0, arg1)
+	ret0, _ := ret[0].(db.PurchaseInvoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePurchaseInvoice indicates an expected call of UpdatePurchaseInvoice.
+func (mr *MockStoreMockRecorder) UpdatePurchaseInvoice(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePurchaseInvoice", reflect.TypeOf((*MockStore)(nil).UpdatePurchaseInvoice), arg0, arg1)
+}
+
+// UpdateSaleInvoiceTotal mocks base method.
+func (m *MockStore) UpdateSaleInvoiceTotal(arg0 context.Context, arg1 int32) (db.SaleInvoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSaleInvoiceTotal", arg0, arg1)
+	ret0, _ := ret[0].(db.SaleInvoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSaleInvoiceTotal indicates an expected call of UpdateSaleInvoiceTotal.
+func (mr *MockStoreMockRecorder) UpdateSaleInvoiceTotal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSaleInvoiceTotal", reflect.TypeOf((*MockStore)(nil).UpdateSaleInvoiceTotal), arg0, arg1)
+}
+
+// UpdateService mocks base method.
+func (m *MockStore) UpdateService(arg0 context.Context, arg1 db.UpdateServiceParams) (db.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateService", arg0, arg1)
+	ret0, _ := ret[0].(db.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateService indicates an expected call of UpdateService.
+func (mr *MockStoreMockRecorder) UpdateService(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateService", reflect.TypeOf((*MockStore)(nil).UpdateService), arg0, arg1)
+}
+
+// UpdateServiceDetailState mocks base method.
+func (m *MockStore) UpdateServiceDetailState(arg0 context.Context, arg1 db.UpdateServiceDetailStateParams) (db.ServiceDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateServiceDetailState", arg0, arg1)
+	ret0, _ := ret[0].(db.ServiceDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateServiceDetailState indicates an expected call of UpdateServiceDetailState.
+func (mr *MockStoreMockRecorder) UpdateServiceDetailState(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceDetailState", reflect.TypeOf((*MockStore)(nil).UpdateServiceDetailState), arg0, arg1)
+}
+
+// UpdateServiceOrder mocks base method.
+func (m *MockStore) UpdateServiceOrder(arg0 context.Context, arg1 db.UpdateServiceOrderParams) (db.ServiceOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateServiceOrder", arg0, arg1)
+	ret0, _ := ret[0].(db.ServiceOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateServiceOrder indicates an expected call of UpdateServiceOrder.
+func (mr *MockStoreMockRecorder) UpdateServiceOrder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceOrder", reflect.TypeOf((*MockStore)(nil).UpdateServiceOrder), arg0, arg1)
+}
+
+// UpdateServiceOrderState mocks base method.
+func (m *MockStore) UpdateServiceOrderState(arg0 context.Context, arg1 db.UpdateServiceOrderStateParams) (db.ServiceOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateServiceOrderState", arg0, arg1)
+	ret0, _ := ret[0].(db.ServiceOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateServiceOrderState indicates an expected call of UpdateServiceOrderState.
+func (mr *MockStoreMockRecorder) UpdateServiceOrderState(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceOrderState", reflect.TypeOf((*MockStore)(nil).UpdateServiceOrderState), arg0, arg1)
+}
+
+// UpdateSupplier mocks base method.
+func (m *MockStore) UpdateSupplier(arg0 context.Context, arg1 db.UpdateSupplierParams) (db.Supplier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSupplier", arg0, arg1)
+	ret0, _ := ret[0].(db.Supplier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSupplier indicates an expected call of UpdateSupplier.
+func (mr *MockStoreMockRecorder) UpdateSupplier(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSupplier", reflect.TypeOf((*MockStore)(nil).UpdateSupplier), arg0, arg1)
+}
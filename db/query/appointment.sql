@@ -0,0 +1,64 @@
+-- name: CreateAppointment :one
+INSERT INTO appointments (
+  customer_id,
+  car_id,
+  bay,
+  starts_at,
+  ends_at,
+  notes
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: AddAppointmentService :exec
+INSERT INTO appointment_services (
+  appointment_id,
+  service_id
+) VALUES (
+  $1, $2
+);
+
+-- name: GetAppointment :one
+SELECT * FROM appointments
+WHERE id = $1 LIMIT 1;
+
+-- name: GetAppointmentForUpdate :one
+SELECT * FROM appointments
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListAppointmentServices :many
+SELECT s.* FROM services s
+JOIN appointment_services aps ON aps.service_id = s.id
+WHERE aps.appointment_id = $1
+ORDER BY s.id;
+
+-- the appointments taking a bay at some point of a period
+
+-- name: ListAppointmentsBetween :many
+SELECT * FROM appointments
+WHERE state <> sqlc.arg(cancelled_state)
+  AND starts_at < sqlc.arg(to_time)
+  AND ends_at > sqlc.arg(from_time)
+ORDER BY bay, starts_at, id;
+
+-- name: ListAppointmentServicesBetween :many
+SELECT aps.appointment_id, s.* FROM appointment_services aps
+JOIN appointments a ON a.id = aps.appointment_id
+JOIN services s ON s.id = aps.service_id
+WHERE a.state <> sqlc.arg(cancelled_state)
+  AND a.starts_at < sqlc.arg(to_time)
+  AND a.ends_at > sqlc.arg(from_time)
+ORDER BY aps.appointment_id, s.id;
+
+-- name: UpdateAppointmentState :one
+UPDATE appointments
+SET state = sqlc.arg(state), service_order_id = sqlc.arg(service_order_id)
+WHERE id = sqlc.arg(id) AND state = sqlc.arg(from_state)
+RETURNING *;
+
+-- appointments are booked one at a time, so that two bookings cannot take the same bay,
+-- the lock is released with the transaction
+
+-- name: LockAppointments :exec
+SELECT pg_advisory_xact_lock(hashtext('appointments'));
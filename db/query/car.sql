@@ -0,0 +1,65 @@
+-- name: CreateCar :one
+INSERT INTO cars (
+  customer_id,
+  registraion_number,
+  make,
+  model,
+  year,
+  energy
+)
+SELECT sqlc.arg(customer_id)::int, sqlc.arg(registraion_number)::varchar, sqlc.arg(make)::varchar,
+  sqlc.arg(model)::varchar, sqlc.arg(year)::varchar, sqlc.arg(energy)::varchar
+WHERE EXISTS (SELECT 1 FROM customers WHERE id = sqlc.arg(customer_id)::int AND deleted_at IS NULL)
+RETURNING *;
+
+-- the deleted cars are hidden from every query but the ones restoring them or reading
+-- their history
+
+-- name: GetCar :one
+SELECT * FROM cars
+WHERE id = $1 AND deleted_at IS NULL LIMIT 1;
+
+-- name: ListCarsByCustomer :many
+SELECT * FROM cars
+WHERE customer_id = $1 AND deleted_at IS NULL
+ORDER BY id;
+
+-- a car can only be moved to a customer that is not deleted
+-- name: UpdateCar :one
+UPDATE cars
+SET customer_id = sqlc.arg(customer_id), registraion_number = sqlc.arg(registraion_number),
+    make = sqlc.arg(make), model = sqlc.arg(model), year = sqlc.arg(year), energy = sqlc.arg(energy),
+    version = version + 1
+WHERE id = sqlc.arg(id) AND deleted_at IS NULL
+  AND (sqlc.narg(versions)::int[] IS NULL OR version = ANY(sqlc.narg(versions)::int[]))
+  AND EXISTS (SELECT 1 FROM customers WHERE id = sqlc.arg(customer_id) AND deleted_at IS NULL)
+RETURNING *;
+
+-- name: SoftDeleteCar :execrows
+UPDATE cars
+SET deleted_at = now(), version = version + 1
+WHERE id = sqlc.arg(id) AND deleted_at IS NULL
+  AND (sqlc.narg(versions)::int[] IS NULL OR version = ANY(sqlc.narg(versions)::int[]));
+
+-- name: SoftDeleteCustomerCars :exec
+UPDATE cars
+SET deleted_at = sqlc.arg(deleted_at), version = version + 1
+WHERE customer_id = sqlc.arg(customer_id) AND deleted_at IS NULL;
+
+-- name: GetCarWithDeleted :one
+SELECT * FROM cars
+WHERE id = $1 LIMIT 1;
+
+-- a car can only be restored with its customer
+-- name: RestoreCar :one
+UPDATE cars
+SET deleted_at = NULL, version = version + 1
+WHERE id = $1 AND deleted_at IS NOT NULL
+  AND EXISTS (SELECT 1 FROM customers WHERE id = cars.customer_id AND deleted_at IS NULL)
+RETURNING *;
+
+-- the cars deleted along with a customer are the ones deleted at the same time
+-- name: RestoreCustomerCars :exec
+UPDATE cars
+SET deleted_at = NULL, version = version + 1
+WHERE customer_id = sqlc.arg(customer_id) AND deleted_at = sqlc.arg(deleted_at);
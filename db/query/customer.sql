@@ -6,23 +6,83 @@ INSERT INTO customers (
   $1, $2
 ) RETURNING *;
 
+-- the deleted customers are hidden from every query but the ones restoring and purging them
+
 -- name: GetCustomer :one
 SELECT * FROM customers
-WHERE id = $1 LIMIT 1;
+WHERE id = $1 AND deleted_at IS NULL LIMIT 1;
 
 -- name: ListCustomers :many
 SELECT * FROM customers
+WHERE id > sqlc.arg(after_id) AND deleted_at IS NULL
 ORDER BY id
-LIMIT $1
-OFFSET $2;
+LIMIT sqlc.arg('limit');
+
+-- name: CountCustomers :one
+SELECT count(*) FROM customers
+WHERE deleted_at IS NULL;
+
+-- the updates and deletes of customers, cars and service orders only change the row when
+-- its version is one of versions, or when versions is NULL
 
 -- name: UpdateCustomer :one
 UPDATE customers
-SET full_name = $2, phone_number = $3
-WHERE id = $1
+SET full_name = sqlc.arg(full_name), phone_number = sqlc.arg(phone_number), version = version + 1
+WHERE id = sqlc.arg(id) AND deleted_at IS NULL
+  AND (sqlc.narg(versions)::int[] IS NULL OR version = ANY(sqlc.narg(versions)::int[]))
 RETURNING *;
 
+-- name: PatchCustomer :one
+UPDATE customers
+SET full_name = COALESCE(sqlc.narg(full_name), full_name),
+    phone_number = COALESCE(sqlc.narg(phone_number), phone_number),
+    version = version + 1
+WHERE id = sqlc.arg(id) AND deleted_at IS NULL
+  AND (sqlc.narg(versions)::int[] IS NULL OR version = ANY(sqlc.narg(versions)::int[]))
+RETURNING *;
 
--- name: DeleteCustomer :exec
+-- name: SoftDeleteCustomer :one
+UPDATE customers
+SET deleted_at = now(), version = version + 1
+WHERE id = sqlc.arg(id) AND deleted_at IS NULL
+  AND (sqlc.narg(versions)::int[] IS NULL OR version = ANY(sqlc.narg(versions)::int[]))
+RETURNING *;
+
+-- name: RestoreCustomer :one
+UPDATE customers
+SET deleted_at = NULL, version = version + 1
+WHERE id = $1 AND deleted_at IS NOT NULL
+RETURNING *;
+
+-- name: GetCustomerForUpdate :one
+SELECT * FROM customers
+WHERE id = $1 LIMIT 1
+FOR UPDATE;
+
+-- the service orders of a customer are locked so none is invoiced while it is purged
+-- name: LockCustomerServiceOrders :exec
+SELECT so.id FROM service_orders so
+JOIN cars c ON c.id = so.car_id
+WHERE c.customer_id = $1
+FOR UPDATE OF so;
+
+-- name: CountCustomerInvoices :one
+SELECT count(*) FROM sale_invoices si
+JOIN service_orders so ON so.id = si.service_order_id
+JOIN cars c ON c.id = so.car_id
+WHERE c.customer_id = $1;
+
+-- name: PurgeCustomer :execrows
 DELETE FROM customers
-WHERE id = $1;
\ No newline at end of file
+WHERE id = $1;
+
+-- name: SearchCustomers :many
+SELECT * FROM customers
+WHERE deleted_at IS NULL
+  AND (full_name ILIKE sqlc.arg(name_pattern)::text
+   OR (sqlc.arg(digits)::text <> '' AND phone_digits(phone_number) LIKE '%' || sqlc.arg(digits)::text || '%'))
+ORDER BY GREATEST(
+    similarity(full_name, sqlc.arg(query)::text),
+    similarity(phone_digits(phone_number), sqlc.arg(digits)::text)
+  ) DESC, id
+LIMIT sqlc.arg('limit');
@@ -0,0 +1,52 @@
+-- name: CreateLaborEntry :one
+INSERT INTO labor_entries (
+  mechanic_id,
+  service_detail_id
+) VALUES (
+  $1, $2
+) RETURNING *;
+
+-- name: GetClockedInLaborEntry :one
+SELECT * FROM labor_entries
+WHERE mechanic_id = $1 AND clocked_out_at IS NULL LIMIT 1;
+
+-- name: ClockOutMechanic :one
+UPDATE labor_entries
+SET clocked_out_at = now()
+WHERE mechanic_id = $1 AND clocked_out_at IS NULL
+RETURNING *;
+
+-- name: ClockOutServiceDetail :exec
+UPDATE labor_entries
+SET clocked_out_at = now()
+WHERE service_detail_id = $1 AND clocked_out_at IS NULL;
+
+-- name: CountServiceDetailLaborEntries :one
+SELECT count(*) FROM labor_entries
+WHERE service_detail_id = $1;
+
+-- name: ListServiceOrderLaborEntries :many
+SELECT le.* FROM labor_entries le
+JOIN service_details sd ON sd.id = le.service_detail_id
+WHERE sd.service_order_id = $1
+ORDER BY le.clocked_in_at, le.id;
+
+-- the hours worked on the services done in a period, the most underquoted services first
+
+-- name: ListServiceLaborReport :many
+SELECT
+  s.id AS service_id,
+  s.name,
+  s.estimation_time,
+  count(DISTINCT sd.id) AS lines,
+  (count(DISTINCT sd.id) * s.estimation_time)::bigint AS estimated_minutes,
+  (SUM(EXTRACT(EPOCH FROM le.clocked_out_at - le.clocked_in_at)) / 60)::bigint AS actual_minutes
+FROM services s
+JOIN service_details sd ON sd.service_id = s.id
+JOIN labor_entries le ON le.service_detail_id = sd.id
+WHERE sd.state = sqlc.arg(done_state)
+  AND sd.stopped_at >= sqlc.arg(from_time)
+  AND sd.stopped_at < sqlc.arg(to_time)
+  AND le.clocked_out_at IS NOT NULL
+GROUP BY s.id
+ORDER BY SUM(EXTRACT(EPOCH FROM le.clocked_out_at - le.clocked_in_at)) / 60 - count(DISTINCT sd.id) * s.estimation_time DESC, s.id;
@@ -0,0 +1,62 @@
+-- name: CreateMechanic :one
+INSERT INTO mechanics (
+  full_name
+) VALUES (
+  $1
+) RETURNING *;
+
+-- name: GetMechanic :one
+SELECT * FROM mechanics
+WHERE id = $1 LIMIT 1;
+
+-- name: ListMechanics :many
+SELECT * FROM mechanics
+WHERE id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT sqlc.arg('limit');
+
+-- name: CountMechanics :one
+SELECT count(*) FROM mechanics;
+
+-- name: UpdateMechanic :one
+UPDATE mechanics
+SET full_name = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteMechanic :execrows
+DELETE FROM mechanics
+WHERE id = $1;
+
+-- name: AssignMechanic :one
+INSERT INTO mechanic_details (
+  mechanic_id,
+  service_order_id
+) VALUES (
+  $1, $2
+) RETURNING *;
+
+-- name: UnassignMechanic :execrows
+DELETE FROM mechanic_details
+WHERE service_order_id = $1 AND mechanic_id = $2;
+
+-- name: ListServiceOrderMechanics :many
+SELECT m.* FROM mechanics m
+JOIN mechanic_details md ON md.mechanic_id = m.id
+WHERE md.service_order_id = $1
+ORDER BY m.full_name;
+
+-- name: ListMechanicWorkload :many
+SELECT so.* FROM service_orders so
+JOIN mechanic_details md ON md.service_order_id = so.id
+WHERE md.mechanic_id = sqlc.arg(mechanic_id)
+  AND so.state <> ALL(sqlc.arg(closed_states)::int[])
+ORDER BY so.date_received, so.id;
+
+-- name: ListCarMechanics :many
+SELECT md.service_order_id, m.id, m.full_name
+FROM mechanic_details md
+JOIN mechanics m ON m.id = md.mechanic_id
+JOIN service_orders so ON so.id = md.service_order_id
+WHERE so.car_id = $1
+ORDER BY md.service_order_id, m.full_name;
@@ -0,0 +1,37 @@
+-- name: CreatePart :one
+INSERT INTO parts (
+  name,
+  description,
+  retail_price,
+  reorder_level
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING *;
+
+-- name: GetPart :one
+SELECT * FROM parts
+WHERE id = $1 LIMIT 1;
+
+-- name: GetPartForUpdate :one
+SELECT * FROM parts
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: UpdatePart :one
+UPDATE parts
+SET name = $2, description = $3, retail_price = $4, reorder_level = $5
+WHERE id = $1
+RETURNING *;
+
+-- name: DeletePart :execrows
+DELETE FROM parts
+WHERE id = $1;
+
+-- name: GetPartStock :one
+SELECT * FROM part_stock
+WHERE part_id = $1 LIMIT 1;
+
+-- name: ListLowStockParts :many
+SELECT * FROM part_stock
+WHERE on_hand <= reorder_level
+ORDER BY on_hand - reorder_level, part_id;
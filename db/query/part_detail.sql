@@ -0,0 +1,26 @@
+-- name: CreatePartDetail :one
+INSERT INTO part_details (
+  part_id,
+  service_order_id,
+  quantity,
+  price
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING *;
+
+-- name: ListServiceOrderParts :many
+SELECT * FROM part_details
+WHERE service_order_id = $1
+ORDER BY id;
+
+-- name: DeletePartDetail :execrows
+DELETE FROM part_details
+WHERE service_order_id = $1 AND part_id = $2;
+
+-- name: ListCarPartDetails :many
+SELECT pd.service_order_id, pd.part_id, p.name, pd.quantity, pd.price
+FROM part_details pd
+JOIN parts p ON p.id = pd.part_id
+JOIN service_orders so ON so.id = pd.service_order_id
+WHERE so.car_id = $1
+ORDER BY pd.service_order_id, pd.id;
@@ -0,0 +1,33 @@
+-- name: CreatePayment :one
+INSERT INTO payments (
+  sale_invoice_id,
+  amount,
+  date
+) VALUES (
+  $1, $2, $3
+) RETURNING *;
+
+-- name: ListInvoicePayments :many
+SELECT * FROM payments
+WHERE sale_invoice_id = $1
+ORDER BY date, id;
+
+-- name: DeletePayment :execrows
+DELETE FROM payments
+WHERE id = $1 AND sale_invoice_id = $2;
+
+-- name: GetSaleInvoiceBalance :one
+SELECT * FROM sale_invoice_balances
+WHERE sale_invoice_id = $1 LIMIT 1;
+
+-- name: ListOutstandingInvoices :many
+SELECT * FROM sale_invoice_balances
+WHERE outstanding > 0
+ORDER BY date, sale_invoice_id;
+
+-- name: ListOutstandingInvoiceAging :many
+SELECT aging_bucket, COUNT(*) AS invoices, SUM(outstanding)::decimal AS outstanding
+FROM sale_invoice_balances
+WHERE outstanding > 0
+GROUP BY aging_bucket
+ORDER BY MIN(age_days);
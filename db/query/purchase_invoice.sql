@@ -0,0 +1,66 @@
+-- name: CreatePurchaseInvoice :one
+INSERT INTO purchase_invoices (
+  supplier_id,
+  ref,
+  date
+) VALUES (
+  $1, $2, $3
+) RETURNING *;
+
+-- name: GetPurchaseInvoice :one
+SELECT * FROM purchase_invoices
+WHERE id = $1 LIMIT 1;
+
+-- name: GetPurchaseInvoiceForUpdate :one
+SELECT * FROM purchase_invoices
+WHERE id = $1 LIMIT 1
+FOR UPDATE;
+
+-- name: ListPurchaseInvoices :many
+SELECT * FROM purchase_invoices
+WHERE id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT sqlc.arg('limit');
+
+-- name: CountPurchaseInvoices :one
+SELECT count(*) FROM purchase_invoices;
+
+-- name: UpdatePurchaseInvoice :one
+UPDATE purchase_invoices
+SET supplier_id = $2, ref = $3, date = $4
+WHERE id = $1
+RETURNING *;
+
+-- name: DeletePurchaseInvoice :execrows
+DELETE FROM purchase_invoices
+WHERE id = $1;
+
+-- name: CreatePurchaseDetail :one
+INSERT INTO purchase_details (
+  part_id,
+  purchase_invoice_id,
+  quantity,
+  price
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING *;
+
+-- name: ListPurchaseDetails :many
+SELECT * FROM purchase_details
+WHERE purchase_invoice_id = $1
+ORDER BY id;
+
+-- name: GetPurchaseDetailForUpdate :one
+SELECT * FROM purchase_details
+WHERE id = $1 AND purchase_invoice_id = $2 LIMIT 1
+FOR UPDATE;
+
+-- name: UpdatePurchaseDetail :one
+UPDATE purchase_details
+SET part_id = $3, quantity = $4, price = $5
+WHERE id = $1 AND purchase_invoice_id = $2
+RETURNING *;
+
+-- name: DeletePurchaseDetail :execrows
+DELETE FROM purchase_details
+WHERE id = $1 AND purchase_invoice_id = $2;
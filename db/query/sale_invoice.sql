@@ -0,0 +1,78 @@
+-- the counter row stays locked until the invoice is committed, so the refs have no gaps
+-- name: NextSaleInvoiceRef :one
+UPDATE sale_invoice_ref_counter
+SET last_ref = last_ref + 1
+RETURNING ('INV-' || LPAD(last_ref::text, 6, '0'))::varchar AS ref;
+
+-- name: CreateSaleInvoice :one
+INSERT INTO sale_invoices (
+  service_order_id,
+  date,
+  ref
+) VALUES (
+  $1, CURRENT_DATE, $2
+) RETURNING *;
+
+-- name: GetSaleInvoice :one
+SELECT * FROM sale_invoices
+WHERE id = $1 LIMIT 1;
+
+-- name: GetSaleInvoiceByServiceOrder :one
+SELECT * FROM sale_invoices
+WHERE service_order_id = $1 LIMIT 1;
+
+-- name: ListSaleInvoices :many
+SELECT * FROM sale_invoices
+WHERE id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT sqlc.arg('limit');
+
+-- name: CountSaleInvoices :one
+SELECT count(*) FROM sale_invoices;
+
+-- name: CreateSaleInvoiceServiceLines :exec
+INSERT INTO sale_invoice_lines (
+  sale_invoice_id,
+  kind,
+  description,
+  quantity,
+  unit_price,
+  amount
+)
+SELECT sqlc.arg(sale_invoice_id)::int, 'service', s.name, 1, COALESCE(sd.price, s.min_price, 0), COALESCE(sd.price, s.min_price, 0)
+FROM service_details sd
+JOIN services s ON s.id = sd.service_id
+WHERE sd.service_order_id = sqlc.arg(service_order_id) AND sd.state <> sqlc.arg(declined_state)
+ORDER BY sd.id;
+
+-- name: CreateSaleInvoicePartLines :exec
+INSERT INTO sale_invoice_lines (
+  sale_invoice_id,
+  kind,
+  description,
+  quantity,
+  unit_price,
+  amount
+)
+SELECT sqlc.arg(sale_invoice_id)::int, 'part', p.name, pd.quantity, COALESCE(pd.price, p.retail_price), COALESCE(pd.price, p.retail_price) * pd.quantity
+FROM part_details pd
+JOIN parts p ON p.id = pd.part_id
+WHERE pd.service_order_id = sqlc.arg(service_order_id)
+ORDER BY pd.id;
+
+-- name: UpdateSaleInvoiceTotal :one
+UPDATE sale_invoices
+SET total = (SELECT COALESCE(SUM(amount), 0) FROM sale_invoice_lines WHERE sale_invoice_id = sqlc.arg(id))
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: ListSaleInvoiceLines :many
+SELECT * FROM sale_invoice_lines
+WHERE sale_invoice_id = $1
+ORDER BY id;
+
+-- name: ListCarSaleInvoices :many
+SELECT si.* FROM sale_invoices si
+JOIN service_orders so ON so.id = si.service_order_id
+WHERE so.car_id = $1
+ORDER BY si.service_order_id, si.id;
@@ -0,0 +1,33 @@
+-- name: CreateService :one
+INSERT INTO services (
+  name,
+  description,
+  estimation_time,
+  min_price,
+  max_price
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: GetService :one
+SELECT * FROM services
+WHERE id = $1 LIMIT 1;
+
+-- name: ListServices :many
+SELECT * FROM services
+WHERE id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT sqlc.arg('limit');
+
+-- name: CountServices :one
+SELECT count(*) FROM services;
+
+-- name: UpdateService :one
+UPDATE services
+SET name = $2, description = $3, estimation_time = $4, min_price = $5, max_price = $6
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteService :execrows
+DELETE FROM services
+WHERE id = $1;
@@ -0,0 +1,42 @@
+-- name: CreateServiceDetail :one
+INSERT INTO service_details (
+  service_id,
+  service_order_id,
+  price,
+  price_override_reason,
+  price_overridden_by
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: ListServiceOrderServices :many
+SELECT * FROM service_details
+WHERE service_order_id = $1
+ORDER BY id;
+
+-- name: DeleteServiceDetail :execrows
+DELETE FROM service_details
+WHERE service_order_id = $1 AND service_id = $2;
+
+-- name: GetServiceDetailForUpdate :one
+SELECT * FROM service_details
+WHERE service_order_id = $1 AND service_id = $2 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: UpdateServiceDetailState :one
+UPDATE service_details
+SET state = sqlc.arg(state), started_at = sqlc.arg(started_at), stopped_at = sqlc.arg(stopped_at)
+WHERE id = sqlc.arg(id) AND state = sqlc.arg(from_state)
+RETURNING *;
+
+-- name: CountUnfinishedServiceDetails :one
+SELECT count(*) FROM service_details
+WHERE service_order_id = sqlc.arg(service_order_id) AND NOT (state = ANY(sqlc.arg(finished_states)::int[]));
+
+-- name: ListCarServiceDetails :many
+SELECT sd.service_order_id, sd.service_id, s.name, sd.price, sd.state
+FROM service_details sd
+JOIN services s ON s.id = sd.service_id
+JOIN service_orders so ON so.id = sd.service_order_id
+WHERE so.car_id = $1
+ORDER BY sd.service_order_id, sd.id;
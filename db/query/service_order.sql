@@ -0,0 +1,49 @@
+-- name: CreateServiceOrder :one
+INSERT INTO service_orders (
+  car_id,
+  description,
+  date_received,
+  state,
+  mileage
+)
+SELECT $1, $2, CURRENT_DATE, $3, $4
+WHERE EXISTS (SELECT 1 FROM cars WHERE id = $1 AND deleted_at IS NULL)
+RETURNING *;
+
+-- name: GetServiceOrder :one
+SELECT * FROM service_orders
+WHERE id = $1 LIMIT 1;
+
+-- name: GetServiceOrderForUpdate :one
+SELECT * FROM service_orders
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: UpdateServiceOrder :one
+UPDATE service_orders
+SET description = sqlc.arg(description), mileage = sqlc.arg(mileage), version = version + 1
+WHERE id = sqlc.arg(id) AND (sqlc.narg(versions)::int[] IS NULL OR version = ANY(sqlc.narg(versions)::int[]))
+RETURNING *;
+
+-- name: PatchServiceOrder :one
+UPDATE service_orders
+SET description = COALESCE(sqlc.narg(description), description),
+    mileage = COALESCE(sqlc.narg(mileage), mileage),
+    version = version + 1
+WHERE id = sqlc.arg(id) AND (sqlc.narg(versions)::int[] IS NULL OR version = ANY(sqlc.narg(versions)::int[]))
+RETURNING *;
+
+-- name: UpdateServiceOrderState :one
+UPDATE service_orders
+SET state = sqlc.arg(state), date_returned = sqlc.arg(date_returned), version = version + 1
+WHERE id = sqlc.arg(id) AND state = sqlc.arg(from_state)
+RETURNING *;
+
+-- name: DeleteServiceOrder :execrows
+DELETE FROM service_orders
+WHERE id = sqlc.arg(id) AND (sqlc.narg(versions)::int[] IS NULL OR version = ANY(sqlc.narg(versions)::int[]));
+
+-- name: ListCarServiceOrders :many
+SELECT * FROM service_orders
+WHERE car_id = $1
+ORDER BY date_received, id;
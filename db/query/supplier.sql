@@ -0,0 +1,31 @@
+-- name: CreateSupplier :one
+INSERT INTO suppliers (
+  name,
+  address,
+  phone_number
+) VALUES (
+  $1, $2, $3
+) RETURNING *;
+
+-- name: GetSupplier :one
+SELECT * FROM suppliers
+WHERE id = $1 LIMIT 1;
+
+-- name: ListSuppliers :many
+SELECT * FROM suppliers
+WHERE id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT sqlc.arg('limit');
+
+-- name: CountSuppliers :one
+SELECT count(*) FROM suppliers;
+
+-- name: UpdateSupplier :one
+UPDATE suppliers
+SET name = $2, address = $3, phone_number = $4
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteSupplier :execrows
+DELETE FROM suppliers
+WHERE id = $1;
@@ -0,0 +1,39 @@
+-- name: CreateUser :one
+INSERT INTO users (
+  username,
+  hashed_password,
+  full_name,
+  role
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING *;
+
+-- the first admin is created one at a time, so that two setups cannot both find no user,
+-- the lock is released with the transaction
+
+-- name: LockUsers :exec
+SELECT pg_advisory_xact_lock(hashtext('users'));
+
+-- name: CreateFirstAdmin :one
+INSERT INTO users (
+  username,
+  hashed_password,
+  full_name,
+  role
+)
+SELECT sqlc.arg(username)::varchar, sqlc.arg(hashed_password)::varchar, sqlc.arg(full_name)::varchar, 'admin'
+WHERE NOT EXISTS (SELECT 1 FROM users)
+RETURNING *;
+
+-- name: GetUser :one
+SELECT * FROM users
+WHERE username = $1 LIMIT 1;
+
+-- name: ListUsers :many
+SELECT * FROM users
+WHERE username > sqlc.arg(after_username)
+ORDER BY username
+LIMIT sqlc.arg('limit');
+
+-- name: CountUsers :one
+SELECT count(*) FROM users;
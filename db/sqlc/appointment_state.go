@@ -0,0 +1,25 @@
+package db
+
+// States of an appointment, as stored in APPOINTMENTS.STATE.
+const (
+	AppointmentBooked int32 = iota + 1
+	AppointmentArrived
+	AppointmentCancelled
+)
+
+var appointmentStateNames = map[int32]string{
+	AppointmentBooked:    "booked",
+	AppointmentArrived:   "arrived",
+	AppointmentCancelled: "cancelled",
+}
+
+// AppointmentStateName returns the name of an appointment state
+func AppointmentStateName(state int32) string {
+	return appointmentStateNames[state]
+}
+
+// CanTransitionAppointment reports whether an appointment may move from one state to another,
+// only a booked appointment can be cancelled or see its car arrive
+func CanTransitionAppointment(from, to int32) bool {
+	return from == AppointmentBooked && (to == AppointmentArrived || to == AppointmentCancelled)
+}
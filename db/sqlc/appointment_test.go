@@ -0,0 +1,137 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/util"
+	"github.com/stretchr/testify/require"
+)
+
+// randomAppointmentTime returns a day far enough in the future not to meet the appointments of
+// other tests, the bays are shared by all of them
+func randomAppointmentTime() time.Time {
+	return time.Now().AddDate(10, 0, int(util.RandomInt(0, 100000))).UTC().Truncate(time.Minute)
+}
+
+func TestBookAppointmentTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	customer := createRandomCustomer(t)
+	car := createRandomCar(t, customer)
+	service1 := createRandomService(t)
+	service2 := createRandomService(t)
+	startsAt := randomAppointmentTime()
+
+	arg := BookAppointmentTxParams{
+		CustomerID: customer.ID,
+		CarID:      car.ID,
+		ServiceIDs: []int32{service1.ID, service2.ID},
+		StartsAt:   startsAt,
+		Bays:       1,
+		Notes:      "brakes are noisy",
+	}
+	result, err := store.BookAppointmentTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	appointment := result.Appointment
+	minutes := time.Duration(service1.EstimationTime+service2.EstimationTime) * time.Minute
+	require.Equal(t, int32(1), appointment.Bay)
+	require.Equal(t, AppointmentBooked, appointment.State)
+	require.WithinDuration(t, startsAt, appointment.StartsAt, time.Second)
+	require.WithinDuration(t, startsAt.Add(minutes), appointment.EndsAt, time.Second)
+	require.Len(t, result.Services, 2)
+
+	// the only bay is taken, and the car cannot be at two places at once
+	other := createRandomCar(t, customer)
+	_, err = store.BookAppointmentTx(context.Background(), BookAppointmentTxParams{
+		CustomerID: customer.ID,
+		CarID:      other.ID,
+		ServiceIDs: []int32{service1.ID},
+		StartsAt:   startsAt.Add(minutes / 2),
+		Bays:       1,
+	})
+	var conflict *ConflictError
+	require.True(t, errors.As(err, &conflict))
+
+	arg.Bays = 2
+	_, err = store.BookAppointmentTx(context.Background(), arg)
+	require.True(t, errors.As(err, &conflict))
+
+	// the car of another customer
+	_, err = store.BookAppointmentTx(context.Background(), BookAppointmentTxParams{
+		CustomerID: createRandomCustomer(t).ID,
+		CarID:      car.ID,
+		ServiceIDs: []int32{service1.ID},
+		StartsAt:   startsAt.AddDate(0, 0, 1),
+		Bays:       1,
+	})
+	require.True(t, errors.As(err, &conflict))
+
+	// a second bay takes a second car
+	booked, err := store.BookAppointmentTx(context.Background(), BookAppointmentTxParams{
+		CustomerID: customer.ID,
+		CarID:      other.ID,
+		ServiceIDs: []int32{service1.ID},
+		StartsAt:   startsAt,
+		Bays:       2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), booked.Appointment.Bay)
+
+	appointments, err := store.ListAppointmentsBetween(context.Background(), ListAppointmentsBetweenParams{
+		CancelledState: AppointmentCancelled,
+		FromTime:       startsAt,
+		ToTime:         startsAt.AddDate(0, 0, 1),
+	})
+	require.NoError(t, err)
+	require.Len(t, appointments, 2)
+	require.Equal(t, appointment.ID, appointments[0].ID)
+}
+
+func TestArriveAppointmentTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	customer := createRandomCustomer(t)
+	car := createRandomCar(t, customer)
+	service := createRandomService(t)
+
+	booked, err := store.BookAppointmentTx(context.Background(), BookAppointmentTxParams{
+		CustomerID: customer.ID,
+		CarID:      car.ID,
+		ServiceIDs: []int32{service.ID},
+		StartsAt:   randomAppointmentTime(),
+		Bays:       1,
+		Notes:      "oil change",
+	})
+	require.NoError(t, err)
+
+	arg := ArriveAppointmentTxParams{
+		ID:      booked.Appointment.ID,
+		Mileage: sql.NullInt32{Int32: 84500, Valid: true},
+	}
+	result, err := store.ArriveAppointmentTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, AppointmentArrived, result.Appointment.State)
+	require.Equal(t, sql.NullInt32{Int32: result.ServiceOrder.ID, Valid: true}, result.Appointment.ServiceOrderID)
+
+	require.Equal(t, car.ID, result.ServiceOrder.CarID)
+	require.Equal(t, ServiceOrderOpen, result.ServiceOrder.State)
+	require.Equal(t, "oil change", result.ServiceOrder.Description.String)
+	require.Equal(t, arg.Mileage, result.ServiceOrder.Mileage)
+
+	require.Len(t, result.ServiceDetails, 1)
+	require.Equal(t, service.ID, result.ServiceDetails[0].ServiceID)
+	require.Equal(t, ServiceDetailPending, result.ServiceDetails[0].State)
+
+	// a car arrives once
+	_, err = store.ArriveAppointmentTx(context.Background(), arg)
+	var conflict *ConflictError
+	require.True(t, errors.As(err, &conflict))
+
+	_, err = store.ArriveAppointmentTx(context.Background(), ArriveAppointmentTxParams{ID: booked.Appointment.ID + 1000000})
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+}
@@ -0,0 +1,199 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// BookAppointmentTxParams contains the input parameters of the appointment booking transaction
+type BookAppointmentTxParams struct {
+	CustomerID int64     `json:"customer_id"`
+	CarID      int32     `json:"car_id"`
+	ServiceIDs []int32   `json:"service_ids"`
+	StartsAt   time.Time `json:"starts_at"`
+	// Bay is the bay booked, the first bay free for the whole slot when 0
+	Bay int32 `json:"bay"`
+	// Bays is the number of bays of the workshop
+	Bays  int32  `json:"bays"`
+	Notes string `json:"notes"`
+}
+
+// BookAppointmentTxResult is the result of the appointment booking transaction
+type BookAppointmentTxResult struct {
+	Appointment Appointment `json:"appointment"`
+	Services    []Service   `json:"services"`
+}
+
+// BookAppointmentTx books a bay for a car of a customer, for the sum of the estimation times of
+// the services requested. A ConflictError is returned when the car is not a car of the customer,
+// the car is already booked during the slot or no bay is free for the whole slot, and
+// sql.ErrNoRows when the customer, the car or a service does not exist.
+func (store *SQLStore) BookAppointmentTx(ctx context.Context, arg BookAppointmentTxParams) (BookAppointmentTxResult, error) {
+	var result BookAppointmentTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		err := q.LockAppointments(ctx)
+		if err != nil {
+			return err
+		}
+
+		customer, err := q.GetCustomer(ctx, arg.CustomerID)
+		if err != nil {
+			return err
+		}
+
+		car, err := q.GetCar(ctx, arg.CarID)
+		if err != nil {
+			return err
+		}
+
+		if int64(car.CustomerID) != customer.ID {
+			return conflictf("car %s is not a car of customer %s", car.RegistraionNumber, customer.FullName)
+		}
+
+		var minutes int32
+		for _, id := range arg.ServiceIDs {
+			service, err := q.GetService(ctx, id)
+			if err != nil {
+				return err
+			}
+			minutes += service.EstimationTime
+			result.Services = append(result.Services, service)
+		}
+		endsAt := arg.StartsAt.Add(time.Duration(minutes) * time.Minute)
+
+		overlapping, err := q.ListAppointmentsBetween(ctx, ListAppointmentsBetweenParams{
+			CancelledState: AppointmentCancelled,
+			FromTime:       arg.StartsAt,
+			ToTime:         endsAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		taken := make(map[int32]bool, len(overlapping))
+		for _, appointment := range overlapping {
+			if appointment.CarID == car.ID {
+				return conflictf("car %s is already booked from %s to %s", car.RegistraionNumber,
+					appointment.StartsAt.Format(time.RFC3339), appointment.EndsAt.Format(time.RFC3339))
+			}
+			taken[appointment.Bay] = true
+		}
+
+		bay := arg.Bay
+		if bay == 0 {
+			for b := int32(1); b <= arg.Bays && bay == 0; b++ {
+				if !taken[b] {
+					bay = b
+				}
+			}
+			if bay == 0 {
+				return conflictf("all %d bays are booked between %s and %s", arg.Bays,
+					arg.StartsAt.Format(time.RFC3339), endsAt.Format(time.RFC3339))
+			}
+		} else if bay > arg.Bays {
+			return conflictf("bay %d does not exist, the workshop has %d bays", bay, arg.Bays)
+		} else if taken[bay] {
+			return conflictf("bay %d is booked between %s and %s", bay,
+				arg.StartsAt.Format(time.RFC3339), endsAt.Format(time.RFC3339))
+		}
+
+		result.Appointment, err = q.CreateAppointment(ctx, CreateAppointmentParams{
+			CustomerID: customer.ID,
+			CarID:      car.ID,
+			Bay:        bay,
+			StartsAt:   arg.StartsAt,
+			EndsAt:     endsAt,
+			Notes:      arg.Notes,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, service := range result.Services {
+			err = q.AddAppointmentService(ctx, AddAppointmentServiceParams{
+				AppointmentID: result.Appointment.ID,
+				ServiceID:     service.ID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	return result, err
+}
+
+// ArriveAppointmentTxParams contains the input parameters of the arrival transaction
+type ArriveAppointmentTxParams struct {
+	ID int32 `json:"id"`
+	// Mileage is the odometer reading of the car, read when it arrives
+	Mileage sql.NullInt32 `json:"mileage"`
+}
+
+// ArriveAppointmentTxResult is the result of the arrival transaction, with the service order
+// opened for the appointment and its service lines
+type ArriveAppointmentTxResult struct {
+	Appointment    Appointment     `json:"appointment"`
+	ServiceOrder   ServiceOrder    `json:"service_order"`
+	ServiceDetails []ServiceDetail `json:"service_details"`
+}
+
+// ArriveAppointmentTx opens a service order when the car of a booked appointment arrives, with a
+// line at the min price of the catalog for every service requested. A ConflictError is returned
+// when the appointment is not booked, and sql.ErrNoRows when the appointment or its car does not
+// exist.
+func (store *SQLStore) ArriveAppointmentTx(ctx context.Context, arg ArriveAppointmentTxParams) (ArriveAppointmentTxResult, error) {
+	var result ArriveAppointmentTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		appointment, err := q.GetAppointmentForUpdate(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		if !CanTransitionAppointment(appointment.State, AppointmentArrived) {
+			return conflictf("appointment %d is %s", appointment.ID, AppointmentStateName(appointment.State))
+		}
+
+		// the order is only created for a car that is not deleted
+		result.ServiceOrder, err = q.CreateServiceOrder(ctx, CreateServiceOrderParams{
+			CarID:       appointment.CarID,
+			Description: sql.NullString{String: appointment.Notes, Valid: appointment.Notes != ""},
+			State:       ServiceOrderOpen,
+			Mileage:     arg.Mileage,
+		})
+		if err != nil {
+			return err
+		}
+
+		services, err := q.ListAppointmentServices(ctx, appointment.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, service := range services {
+			detail, err := q.CreateServiceDetail(ctx, CreateServiceDetailParams{
+				ServiceID:      service.ID,
+				ServiceOrderID: result.ServiceOrder.ID,
+				Price:          service.MinPrice,
+			})
+			if err != nil {
+				return err
+			}
+			result.ServiceDetails = append(result.ServiceDetails, detail)
+		}
+
+		result.Appointment, err = q.UpdateAppointmentState(ctx, UpdateAppointmentStateParams{
+			ID:             appointment.ID,
+			FromState:      appointment.State,
+			State:          AppointmentArrived,
+			ServiceOrderID: sql.NullInt32{Int32: result.ServiceOrder.ID, Valid: true},
+		})
+		return err
+	})
+
+	return result, err
+}
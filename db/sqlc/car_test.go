@@ -0,0 +1,145 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomCar(t *testing.T, customer Customer) Car {
+
+	arg := CreateCarParams{
+		CustomerID:        int32(customer.ID),
+		RegistraionNumber: util.RandomRegistration(),
+		Make:              util.RandomName(),
+		Model:             util.RandomName(),
+		Year:              fmt.Sprint(util.RandomInt(1990, 2022)),
+		Energy:            util.RandomEnergy(),
+	}
+
+	car, err := testQueries.CreateCar(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, car)
+
+	require.Equal(t, arg.CustomerID, car.CustomerID)
+	require.Equal(t, arg.RegistraionNumber, car.RegistraionNumber)
+	require.Equal(t, arg.Make, car.Make)
+	require.Equal(t, arg.Model, car.Model)
+	require.Equal(t, arg.Year, car.Year)
+	require.Equal(t, arg.Energy, car.Energy)
+
+	require.NotZero(t, car.ID)
+
+	return car
+}
+
+func TestCreateCar(t *testing.T) {
+	createRandomCar(t, createRandomCustomer(t))
+}
+
+func TestGetCar(t *testing.T) {
+	car1 := createRandomCar(t, createRandomCustomer(t))
+	car2, err := testQueries.GetCar(context.Background(), car1.ID)
+	require.NoError(t, err)
+	require.NotEmpty(t, car2)
+
+	require.Equal(t, car1, car2)
+}
+
+func TestUpdateCar(t *testing.T) {
+	car1 := createRandomCar(t, createRandomCustomer(t))
+	owner := createRandomCustomer(t)
+
+	arg := UpdateCarParams{
+		ID:                car1.ID,
+		CustomerID:        int32(owner.ID),
+		RegistraionNumber: util.RandomRegistration(),
+		Make:              car1.Make,
+		Model:             car1.Model,
+		Year:              car1.Year,
+		Energy:            util.RandomEnergy(),
+	}
+
+	car2, err := testQueries.UpdateCar(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, car2)
+
+	require.Equal(t, car1.ID, car2.ID)
+	require.Equal(t, arg.CustomerID, car2.CustomerID)
+	require.Equal(t, arg.RegistraionNumber, car2.RegistraionNumber)
+	require.Equal(t, arg.Energy, car2.Energy)
+
+	// a car is not moved to a deleted customer
+	deleted, err := testQueries.SoftDeleteCustomer(context.Background(), SoftDeleteCustomerParams{ID: createRandomCustomer(t).ID})
+	require.NoError(t, err)
+
+	arg.CustomerID = int32(deleted.ID)
+	_, err = testQueries.UpdateCar(context.Background(), arg)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+}
+
+func TestSoftDeleteCar(t *testing.T) {
+	car1 := createRandomCar(t, createRandomCustomer(t))
+	rows, err := testQueries.SoftDeleteCar(context.Background(), SoftDeleteCarParams{ID: car1.ID})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rows)
+
+	car2, err := testQueries.GetCar(context.Background(), car1.ID)
+	require.Error(t, err)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+	require.Empty(t, car2)
+
+	// but kept for its history
+	car2, err = testQueries.GetCarWithDeleted(context.Background(), car1.ID)
+	require.NoError(t, err)
+	require.True(t, car2.DeletedAt.Valid)
+
+	rows, err = testQueries.SoftDeleteCar(context.Background(), SoftDeleteCarParams{ID: car1.ID})
+	require.NoError(t, err)
+	require.Zero(t, rows)
+
+	// the registration number of a deleted car can be used again
+	car3, err := testQueries.CreateCar(context.Background(), CreateCarParams{
+		CustomerID:        car1.CustomerID,
+		RegistraionNumber: car1.RegistraionNumber,
+		Make:              car1.Make,
+		Model:             car1.Model,
+		Year:              car1.Year,
+		Energy:            car1.Energy,
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, car1.ID, car3.ID)
+
+	// but not restored while in use
+	_, err = testQueries.RestoreCar(context.Background(), car1.ID)
+	require.Error(t, err)
+
+	rows, err = testQueries.SoftDeleteCar(context.Background(), SoftDeleteCarParams{ID: car3.ID})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rows)
+
+	car4, err := testQueries.RestoreCar(context.Background(), car1.ID)
+	require.NoError(t, err)
+	require.Equal(t, car1.ID, car4.ID)
+	require.False(t, car4.DeletedAt.Valid)
+}
+
+func TestListCarsByCustomer(t *testing.T) {
+	customer := createRandomCustomer(t)
+	for i := 0; i < 3; i++ {
+		createRandomCar(t, customer)
+	}
+	createRandomCar(t, createRandomCustomer(t))
+
+	cars, err := testQueries.ListCarsByCustomer(context.Background(), int32(customer.ID))
+	require.NoError(t, err)
+	require.Len(t, cars, 3)
+
+	for _, car := range cars {
+		require.Equal(t, int32(customer.ID), car.CustomerID)
+	}
+}
@@ -0,0 +1,36 @@
+package db
+
+import (
+	"context"
+)
+
+// RestoreCarTx restores a soft deleted car. A ConflictError is returned when the car is not
+// deleted or its customer is, as the car of a deleted customer is restored with the customer,
+// and sql.ErrNoRows when the car does not exist.
+func (store *SQLStore) RestoreCarTx(ctx context.Context, id int32) (Car, error) {
+	var result Car
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		car, err := q.GetCarWithDeleted(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !car.DeletedAt.Valid {
+			return conflictf("car %d is not deleted", car.ID)
+		}
+
+		// the customer is locked so it is not deleted while its car is restored
+		customer, err := q.GetCustomerForUpdate(ctx, int64(car.CustomerID))
+		if err != nil {
+			return err
+		}
+		if customer.DeletedAt.Valid {
+			return conflictf("customer %d of car %d is deleted, restore the customer", customer.ID, car.ID)
+		}
+
+		result, err = q.RestoreCar(ctx, car.ID)
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,44 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestoreCarTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	customer := createRandomCustomer(t)
+	car := createRandomCar(t, customer)
+
+	// a car that is not deleted cannot be restored
+	_, err := store.RestoreCarTx(context.Background(), car.ID)
+	var conflict *ConflictError
+	require.True(t, errors.As(err, &conflict))
+
+	err = store.DeleteCustomerTx(context.Background(), SoftDeleteCustomerParams{ID: customer.ID})
+	require.NoError(t, err)
+
+	// nor the car of a deleted customer
+	_, err = store.RestoreCarTx(context.Background(), car.ID)
+	require.True(t, errors.As(err, &conflict))
+
+	_, err = store.RestoreCustomerTx(context.Background(), customer.ID)
+	require.NoError(t, err)
+
+	rows, err := store.SoftDeleteCar(context.Background(), SoftDeleteCarParams{ID: car.ID})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rows)
+
+	restored, err := store.RestoreCarTx(context.Background(), car.ID)
+	require.NoError(t, err)
+	require.Equal(t, car.ID, restored.ID)
+	require.False(t, restored.DeletedAt.Valid)
+
+	_, err = store.RestoreCarTx(context.Background(), car.ID+1_000_000)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+}
@@ -1,21 +1,50 @@
 // Code generated by sqlc. DO NOT EDIT.
 // versions:
-//   sqlc v1.13.0
+//   sqlc v1.14.0
 // source: customer.sql
 
 package db
 
 import (
 	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
 )
 
+const countCustomerInvoices = `-- name: CountCustomerInvoices :one
+SELECT count(*) FROM sale_invoices si
+JOIN service_orders so ON so.id = si.service_order_id
+JOIN cars c ON c.id = so.car_id
+WHERE c.customer_id = $1
+`
+
+func (q *Queries) CountCustomerInvoices(ctx context.Context, customerID int32) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countCustomerInvoices, customerID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countCustomers = `-- name: CountCustomers :one
+SELECT count(*) FROM customers
+WHERE deleted_at IS NULL
+`
+
+func (q *Queries) CountCustomers(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countCustomers)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createCustomer = `-- name: CreateCustomer :one
 INSERT INTO customers (
   full_name,
   phone_number
 ) VALUES (
   $1, $2
-) RETURNING id, full_name, phone_number, created_at
+) RETURNING id, full_name, phone_number, created_at, version, deleted_at
 `
 
 type CreateCustomerParams struct {
@@ -31,51 +60,65 @@ func (q *Queries) CreateCustomer(ctx context.Context, arg CreateCustomerParams)
 		&i.FullName,
 		&i.PhoneNumber,
 		&i.CreatedAt,
+		&i.Version,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
-const deleteCustomer = `-- name: DeleteCustomer :exec
-DELETE FROM customers
-WHERE id = $1
+const getCustomer = `-- name: GetCustomer :one
+SELECT id, full_name, phone_number, created_at, version, deleted_at FROM customers
+WHERE id = $1 AND deleted_at IS NULL LIMIT 1
 `
 
-func (q *Queries) DeleteCustomer(ctx context.Context, id int64) error {
-	_, err := q.db.ExecContext(ctx, deleteCustomer, id)
-	return err
+func (q *Queries) GetCustomer(ctx context.Context, id int64) (Customer, error) {
+	row := q.db.QueryRowContext(ctx, getCustomer, id)
+	var i Customer
+	err := row.Scan(
+		&i.ID,
+		&i.FullName,
+		&i.PhoneNumber,
+		&i.CreatedAt,
+		&i.Version,
+		&i.DeletedAt,
+	)
+	return i, err
 }
 
-const getCustomer = `-- name: GetCustomer :one
-SELECT id, full_name, phone_number, created_at FROM customers
+const getCustomerForUpdate = `-- name: GetCustomerForUpdate :one
+SELECT id, full_name, phone_number, created_at, version, deleted_at FROM customers
 WHERE id = $1 LIMIT 1
+FOR UPDATE
 `
 
-func (q *Queries) GetCustomer(ctx context.Context, id int64) (Customer, error) {
-	row := q.db.QueryRowContext(ctx, getCustomer, id)
+func (q *Queries) GetCustomerForUpdate(ctx context.Context, id int64) (Customer, error) {
+	row := q.db.QueryRowContext(ctx, getCustomerForUpdate, id)
 	var i Customer
 	err := row.Scan(
 		&i.ID,
 		&i.FullName,
 		&i.PhoneNumber,
 		&i.CreatedAt,
+		&i.Version,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
 const listCustomers = `-- name: ListCustomers :many
-SELECT id, full_name, phone_number, created_at FROM customers
+SELECT id, full_name, phone_number, created_at, version, deleted_at FROM customers
+WHERE id > $1 AND deleted_at IS NULL
 ORDER BY id
-LIMIT $1
-OFFSET $2
+LIMIT $2
 `
 
 type ListCustomersParams struct {
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
+	AfterID int64 `json:"after_id"`
+	Limit   int32 `json:"limit"`
 }
 
 func (q *Queries) ListCustomers(ctx context.Context, arg ListCustomersParams) ([]Customer, error) {
-	rows, err := q.db.QueryContext(ctx, listCustomers, arg.Limit, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, listCustomers, arg.AfterID, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
@@ -88,6 +131,8 @@ func (q *Queries) ListCustomers(ctx context.Context, arg ListCustomersParams) ([
 			&i.FullName,
 			&i.PhoneNumber,
 			&i.CreatedAt,
+			&i.Version,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -102,27 +147,199 @@ func (q *Queries) ListCustomers(ctx context.Context, arg ListCustomersParams) ([
 	return items, nil
 }
 
-const updateCustomer = `-- name: UpdateCustomer :one
+const lockCustomerServiceOrders = `-- name: LockCustomerServiceOrders :exec
+SELECT so.id FROM service_orders so
+JOIN cars c ON c.id = so.car_id
+WHERE c.customer_id = $1
+FOR UPDATE OF so
+`
+
+func (q *Queries) LockCustomerServiceOrders(ctx context.Context, customerID int32) error {
+	_, err := q.db.ExecContext(ctx, lockCustomerServiceOrders, customerID)
+	return err
+}
+
+const patchCustomer = `-- name: PatchCustomer :one
 UPDATE customers
-SET full_name = $2, phone_number = $3
+SET full_name = COALESCE($1, full_name),
+    phone_number = COALESCE($2, phone_number),
+    version = version + 1
+WHERE id = $3 AND deleted_at IS NULL
+  AND ($4::int[] IS NULL OR version = ANY($4::int[]))
+RETURNING id, full_name, phone_number, created_at, version, deleted_at
+`
+
+type PatchCustomerParams struct {
+	FullName    sql.NullString `json:"full_name"`
+	PhoneNumber sql.NullString `json:"phone_number"`
+	ID          int64          `json:"id"`
+	Versions    []int32        `json:"versions"`
+}
+
+func (q *Queries) PatchCustomer(ctx context.Context, arg PatchCustomerParams) (Customer, error) {
+	row := q.db.QueryRowContext(ctx, patchCustomer,
+		arg.FullName,
+		arg.PhoneNumber,
+		arg.ID,
+		pq.Array(arg.Versions),
+	)
+	var i Customer
+	err := row.Scan(
+		&i.ID,
+		&i.FullName,
+		&i.PhoneNumber,
+		&i.CreatedAt,
+		&i.Version,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const purgeCustomer = `-- name: PurgeCustomer :execrows
+DELETE FROM customers
 WHERE id = $1
-RETURNING id, full_name, phone_number, created_at
+`
+
+func (q *Queries) PurgeCustomer(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.ExecContext(ctx, purgeCustomer, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const restoreCustomer = `-- name: RestoreCustomer :one
+UPDATE customers
+SET deleted_at = NULL, version = version + 1
+WHERE id = $1 AND deleted_at IS NOT NULL
+RETURNING id, full_name, phone_number, created_at, version, deleted_at
+`
+
+func (q *Queries) RestoreCustomer(ctx context.Context, id int64) (Customer, error) {
+	row := q.db.QueryRowContext(ctx, restoreCustomer, id)
+	var i Customer
+	err := row.Scan(
+		&i.ID,
+		&i.FullName,
+		&i.PhoneNumber,
+		&i.CreatedAt,
+		&i.Version,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const searchCustomers = `-- name: SearchCustomers :many
+SELECT id, full_name, phone_number, created_at, version, deleted_at FROM customers
+WHERE deleted_at IS NULL
+  AND (full_name ILIKE $1::text
+   OR ($2::text <> '' AND phone_digits(phone_number) LIKE '%' || $2::text || '%'))
+ORDER BY GREATEST(
+    similarity(full_name, $3::text),
+    similarity(phone_digits(phone_number), $2::text)
+  ) DESC, id
+LIMIT $4
+`
+
+type SearchCustomersParams struct {
+	NamePattern string `json:"name_pattern"`
+	Digits      string `json:"digits"`
+	Query       string `json:"query"`
+	Limit       int32  `json:"limit"`
+}
+
+func (q *Queries) SearchCustomers(ctx context.Context, arg SearchCustomersParams) ([]Customer, error) {
+	rows, err := q.db.QueryContext(ctx, searchCustomers,
+		arg.NamePattern,
+		arg.Digits,
+		arg.Query,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Customer
+	for rows.Next() {
+		var i Customer
+		if err := rows.Scan(
+			&i.ID,
+			&i.FullName,
+			&i.PhoneNumber,
+			&i.CreatedAt,
+			&i.Version,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const softDeleteCustomer = `-- name: SoftDeleteCustomer :one
+UPDATE customers
+SET deleted_at = now(), version = version + 1
+WHERE id = $1 AND deleted_at IS NULL
+  AND ($2::int[] IS NULL OR version = ANY($2::int[]))
+RETURNING id, full_name, phone_number, created_at, version, deleted_at
+`
+
+type SoftDeleteCustomerParams struct {
+	ID       int64   `json:"id"`
+	Versions []int32 `json:"versions"`
+}
+
+func (q *Queries) SoftDeleteCustomer(ctx context.Context, arg SoftDeleteCustomerParams) (Customer, error) {
+	row := q.db.QueryRowContext(ctx, softDeleteCustomer, arg.ID, pq.Array(arg.Versions))
+	var i Customer
+	err := row.Scan(
+		&i.ID,
+		&i.FullName,
+		&i.PhoneNumber,
+		&i.CreatedAt,
+		&i.Version,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const updateCustomer = `-- name: UpdateCustomer :one
+UPDATE customers
+SET full_name = $1, phone_number = $2, version = version + 1
+WHERE id = $3 AND deleted_at IS NULL
+  AND ($4::int[] IS NULL OR version = ANY($4::int[]))
+RETURNING id, full_name, phone_number, created_at, version, deleted_at
 `
 
 type UpdateCustomerParams struct {
-	ID          int64  `json:"id"`
-	FullName    string `json:"full_name"`
-	PhoneNumber string `json:"phone_number"`
+	FullName    string  `json:"full_name"`
+	PhoneNumber string  `json:"phone_number"`
+	ID          int64   `json:"id"`
+	Versions    []int32 `json:"versions"`
 }
 
 func (q *Queries) UpdateCustomer(ctx context.Context, arg UpdateCustomerParams) (Customer, error) {
-	row := q.db.QueryRowContext(ctx, updateCustomer, arg.ID, arg.FullName, arg.PhoneNumber)
+	row := q.db.QueryRowContext(ctx, updateCustomer,
+		arg.FullName,
+		arg.PhoneNumber,
+		arg.ID,
+		pq.Array(arg.Versions),
+	)
 	var i Customer
 	err := row.Scan(
 		&i.ID,
 		&i.FullName,
 		&i.PhoneNumber,
 		&i.CreatedAt,
+		&i.Version,
+		&i.DeletedAt,
 	)
 	return i, err
 }
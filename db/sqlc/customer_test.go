@@ -3,6 +3,8 @@ package db
 import (
 	"context"
 	"database/sql"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -64,17 +66,64 @@ func TestUpdateCustomer(t *testing.T) {
 	require.Equal(t, arg.FullName, customer2.FullName)
 	require.Equal(t, arg.PhoneNumber, customer2.PhoneNumber)
 	require.WithinDuration(t, customer1.CreatedAt, customer2.CreatedAt, time.Second)
+	require.Equal(t, customer1.Version+1, customer2.Version)
+
+	// the version read before the update no longer matches
+	arg.Versions = []int32{customer1.Version}
+	_, err = testQueries.UpdateCustomer(context.Background(), arg)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+
+	arg.Versions = []int32{customer1.Version, customer2.Version}
+	customer3, err := testQueries.UpdateCustomer(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, customer2.Version+1, customer3.Version)
 }
 
-func TestDeleteCustomer(t *testing.T) {
+func TestPatchCustomer(t *testing.T) {
 	customer1 := createRandomCustomer(t)
-	err := testQueries.DeleteCustomer(context.Background(), customer1.ID)
+
+	arg := PatchCustomerParams{
+		ID:       customer1.ID,
+		FullName: sql.NullString{String: util.RandomName(), Valid: true},
+	}
+	customer2, err := testQueries.PatchCustomer(context.Background(), arg)
 	require.NoError(t, err)
+	require.Equal(t, customer1.ID, customer2.ID)
+	require.Equal(t, arg.FullName.String, customer2.FullName)
+	require.Equal(t, customer1.PhoneNumber, customer2.PhoneNumber)
 
-	customer2, err := testQueries.GetCustomer(context.Background(), customer1.ID)
+	arg = PatchCustomerParams{
+		ID:          customer1.ID,
+		PhoneNumber: sql.NullString{String: util.RandomPhone(), Valid: true},
+	}
+	customer3, err := testQueries.PatchCustomer(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, customer2.FullName, customer3.FullName)
+	require.Equal(t, arg.PhoneNumber.String, customer3.PhoneNumber)
+
+	_, err = testQueries.PatchCustomer(context.Background(), PatchCustomerParams{ID: customer1.ID + 1000000})
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+}
+
+func TestSoftDeleteCustomer(t *testing.T) {
+	customer1 := createRandomCustomer(t)
+	require.Equal(t, int32(1), customer1.Version)
+
+	_, err := testQueries.SoftDeleteCustomer(context.Background(), SoftDeleteCustomerParams{ID: customer1.ID, Versions: []int32{2}})
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+
+	customer2, err := testQueries.SoftDeleteCustomer(context.Background(), SoftDeleteCustomerParams{ID: customer1.ID, Versions: []int32{1}})
+	require.NoError(t, err)
+	require.Equal(t, customer1.ID, customer2.ID)
+	require.True(t, customer2.DeletedAt.Valid)
+
+	customer3, err := testQueries.GetCustomer(context.Background(), customer1.ID)
 	require.Error(t, err)
 	require.EqualError(t, err, sql.ErrNoRows.Error())
-	require.Empty(t, customer2)
+	require.Empty(t, customer3)
+
+	_, err = testQueries.SoftDeleteCustomer(context.Background(), SoftDeleteCustomerParams{ID: customer1.ID})
+	require.EqualError(t, err, sql.ErrNoRows.Error())
 }
 
 func TestListCustomers(t *testing.T) {
@@ -84,15 +133,57 @@ func TestListCustomers(t *testing.T) {
 	}
 
 	arg := ListCustomersParams{
-		Limit:  5,
-		Offset: 0,
+		Limit:   5,
+		AfterID: 0,
 	}
 
 	customers, err := testQueries.ListCustomers(context.Background(), arg)
 	require.NoError(t, err)
-	require.NotEmpty(t, customers)
+	require.Len(t, customers, 5)
 
 	for _, customer := range customers {
 		require.NotEmpty(t, customer)
 	}
+
+	// the next page starts after the last customer of the previous one
+	arg.AfterID = customers[len(customers)-1].ID
+	next, err := testQueries.ListCustomers(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, next)
+	require.Greater(t, next[0].ID, arg.AfterID)
+
+	count, err := testQueries.CountCustomers(context.Background())
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, count, int64(10))
+}
+
+func TestSearchCustomers(t *testing.T) {
+	name := util.RandomString(8)
+	customer1, err := testQueries.CreateCustomer(context.Background(), CreateCustomerParams{
+		FullName:    "Karim " + name,
+		PhoneNumber: "+213 550 " + strconv.Itoa(int(util.RandomInt(100000, 999999))),
+	})
+	require.NoError(t, err)
+
+	// the case of the name and the spacing of the phone number are ignored
+	customers, err := testQueries.SearchCustomers(context.Background(), SearchCustomersParams{
+		NamePattern: "%" + strings.ToUpper(name) + "%",
+		Digits:      "",
+		Query:       strings.ToUpper(name),
+		Limit:       10,
+	})
+	require.NoError(t, err)
+	require.Len(t, customers, 1)
+	require.Equal(t, customer1.ID, customers[0].ID)
+
+	digits := "550" + customer1.PhoneNumber[len(customer1.PhoneNumber)-6:]
+	customers, err = testQueries.SearchCustomers(context.Background(), SearchCustomersParams{
+		NamePattern: "%" + digits + "%",
+		Digits:      digits,
+		Query:       digits,
+		Limit:       10,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, customers)
+	require.Equal(t, customer1.ID, customers[0].ID)
 }
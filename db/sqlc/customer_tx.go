@@ -0,0 +1,79 @@
+package db
+
+import (
+	"context"
+)
+
+// DeleteCustomerTx soft deletes a customer and its cars, they are hidden until the customer is
+// restored while their service orders and invoices are kept. sql.ErrNoRows is returned when no
+// customer, or no customer of one of the versions, was deleted.
+func (store *SQLStore) DeleteCustomerTx(ctx context.Context, arg SoftDeleteCustomerParams) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		customer, err := q.SoftDeleteCustomer(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		return q.SoftDeleteCustomerCars(ctx, SoftDeleteCustomerCarsParams{
+			DeletedAt:  customer.DeletedAt,
+			CustomerID: int32(customer.ID),
+		})
+	})
+}
+
+// RestoreCustomerTx restores a soft deleted customer with the cars deleted along with it, the
+// cars deleted on their own before stay deleted. A ConflictError is returned when the customer
+// is not deleted, and sql.ErrNoRows when it does not exist.
+func (store *SQLStore) RestoreCustomerTx(ctx context.Context, id int64) (Customer, error) {
+	var result Customer
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		customer, err := q.GetCustomerForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !customer.DeletedAt.Valid {
+			return conflictf("customer %d is not deleted", customer.ID)
+		}
+
+		result, err = q.RestoreCustomer(ctx, customer.ID)
+		if err != nil {
+			return err
+		}
+
+		return q.RestoreCustomerCars(ctx, RestoreCustomerCarsParams{
+			CustomerID: int32(customer.ID),
+			DeletedAt:  customer.DeletedAt,
+		})
+	})
+
+	return result, err
+}
+
+// PurgeCustomerTx deletes a customer, deleted or not, for good with its cars and their service
+// orders. A ConflictError is returned when one of the orders was invoiced, as the invoices must
+// be kept, and sql.ErrNoRows when the customer does not exist.
+func (store *SQLStore) PurgeCustomerTx(ctx context.Context, id int64) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		customer, err := q.GetCustomerForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		err = q.LockCustomerServiceOrders(ctx, int32(customer.ID))
+		if err != nil {
+			return err
+		}
+
+		invoices, err := q.CountCustomerInvoices(ctx, int32(customer.ID))
+		if err != nil {
+			return err
+		}
+		if invoices > 0 {
+			return conflictf("customer %d has %d invoices, only a customer without invoices can be purged", customer.ID, invoices)
+		}
+
+		_, err = q.PurgeCustomer(ctx, customer.ID)
+		return err
+	})
+}
@@ -0,0 +1,105 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteCustomerTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	customer := createRandomCustomer(t)
+	car := createRandomCar(t, customer)
+	order := createRandomServiceOrder(t, car)
+
+	err := store.DeleteCustomerTx(context.Background(), SoftDeleteCustomerParams{ID: customer.ID})
+	require.NoError(t, err)
+
+	_, err = store.GetCustomer(context.Background(), customer.ID)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+	_, err = store.GetCar(context.Background(), car.ID)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+
+	// the service orders are kept
+	_, err = store.GetServiceOrder(context.Background(), order.ID)
+	require.NoError(t, err)
+
+	// no new car or service order for a deleted customer
+	_, err = store.CreateCar(context.Background(), CreateCarParams{
+		CustomerID:        int32(customer.ID),
+		RegistraionNumber: car.RegistraionNumber + "X",
+		Make:              car.Make,
+		Model:             car.Model,
+		Year:              car.Year,
+		Energy:            car.Energy,
+	})
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+
+	err = store.DeleteCustomerTx(context.Background(), SoftDeleteCustomerParams{ID: customer.ID})
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+}
+
+func TestRestoreCustomerTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	customer := createRandomCustomer(t)
+	car1 := createRandomCar(t, customer)
+	car2 := createRandomCar(t, customer)
+
+	// a car deleted before its customer stays deleted
+	rows, err := store.SoftDeleteCar(context.Background(), SoftDeleteCarParams{ID: car2.ID})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rows)
+
+	// a customer that is not deleted cannot be restored
+	_, err = store.RestoreCustomerTx(context.Background(), customer.ID)
+	var conflict *ConflictError
+	require.True(t, errors.As(err, &conflict))
+
+	err = store.DeleteCustomerTx(context.Background(), SoftDeleteCustomerParams{ID: customer.ID})
+	require.NoError(t, err)
+
+	restored, err := store.RestoreCustomerTx(context.Background(), customer.ID)
+	require.NoError(t, err)
+	require.Equal(t, customer.ID, restored.ID)
+	require.False(t, restored.DeletedAt.Valid)
+
+	_, err = store.GetCar(context.Background(), car1.ID)
+	require.NoError(t, err)
+	_, err = store.GetCar(context.Background(), car2.ID)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+}
+
+func TestPurgeCustomerTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	customer := createRandomCustomer(t)
+	order := createRandomServiceOrder(t, createRandomCar(t, customer))
+
+	err := store.PurgeCustomerTx(context.Background(), customer.ID)
+	require.NoError(t, err)
+
+	_, err = store.GetServiceOrder(context.Background(), order.ID)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+
+	err = store.PurgeCustomerTx(context.Background(), customer.ID)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+}
+
+func TestPurgeCustomerTxInvoiced(t *testing.T) {
+	store := NewStore(testDB)
+
+	customer := createRandomCustomer(t)
+	createRandomSaleInvoice(t, createRandomServiceOrder(t, createRandomCar(t, customer)))
+
+	err := store.PurgeCustomerTx(context.Background(), customer.ID)
+	var conflict *ConflictError
+	require.True(t, errors.As(err, &conflict))
+
+	_, err = store.GetCustomer(context.Background(), customer.ID)
+	require.NoError(t, err)
+}
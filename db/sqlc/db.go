@@ -1,6 +1,6 @@
 // Code generated by sqlc. DO NOT EDIT.
 // versions:
-//   sqlc v1.13.0
+//   sqlc v1.14.0
 
 package db
 
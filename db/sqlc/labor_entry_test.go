@@ -0,0 +1,89 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClockInTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	mechanic := createRandomMechanic(t)
+	service := createRandomService(t)
+	order := createRandomServiceOrder(t, createRandomCar(t, createRandomCustomer(t)))
+	_, err := store.AddServiceDetailTx(context.Background(), AddServiceDetailTxParams{
+		ServiceOrderID: order.ID,
+		ServiceID:      service.ID,
+	})
+	require.NoError(t, err)
+
+	arg := ClockInTxParams{
+		MechanicID:     mechanic.ID,
+		ServiceOrderID: order.ID,
+		ServiceID:      service.ID,
+	}
+	entry, err := store.ClockInTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, mechanic.ID, entry.MechanicID)
+	require.WithinDuration(t, time.Now(), entry.ClockedInAt, time.Minute)
+	require.False(t, entry.ClockedOutAt.Valid)
+
+	// clocking in starts the line
+	details, err := store.ListServiceOrderServices(context.Background(), order.ID)
+	require.NoError(t, err)
+	require.Equal(t, ServiceDetailStarted, details[0].State)
+	require.True(t, details[0].StartedAt.Valid)
+
+	_, err = store.ClockInTx(context.Background(), arg)
+	var conflict *ConflictError
+	require.True(t, errors.As(err, &conflict))
+
+	clockedOut, err := store.ClockOutMechanic(context.Background(), mechanic.ID)
+	require.NoError(t, err)
+	require.Equal(t, entry.ID, clockedOut.ID)
+	require.True(t, clockedOut.ClockedOutAt.Valid)
+
+	_, err = store.ClockOutMechanic(context.Background(), mechanic.ID)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+
+	// finishing the line clocks out the mechanics still on it
+	_, err = store.ClockInTx(context.Background(), arg)
+	require.NoError(t, err)
+	_, err = store.TransitionServiceDetailTx(context.Background(), TransitionServiceDetailTxParams{
+		ServiceOrderID: order.ID,
+		ServiceID:      service.ID,
+		State:          ServiceDetailDone,
+	})
+	require.NoError(t, err)
+	_, err = store.GetClockedInLaborEntry(context.Background(), mechanic.ID)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+
+	entries, err := store.ListServiceOrderLaborEntries(context.Background(), order.ID)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	// a done line takes no more time
+	_, err = store.ClockInTx(context.Background(), arg)
+	require.True(t, errors.As(err, &conflict))
+
+	report, err := store.ListServiceLaborReport(context.Background(), ListServiceLaborReportParams{
+		DoneState: ServiceDetailDone,
+		FromTime:  time.Now().Add(-time.Hour),
+		ToTime:    time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	found := false
+	for _, row := range report {
+		if row.ServiceID == service.ID {
+			found = true
+			require.Equal(t, int64(1), row.Lines)
+			require.Equal(t, int64(service.EstimationTime), row.EstimatedMinutes)
+		}
+	}
+	require.True(t, found)
+}
@@ -0,0 +1,73 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// ClockInTxParams contains the input parameters of the clock in transaction
+type ClockInTxParams struct {
+	MechanicID     int32 `json:"mechanic_id"`
+	ServiceOrderID int32 `json:"service_order_id"`
+	ServiceID      int32 `json:"service_id"`
+}
+
+// ClockInTx clocks a mechanic in on a service line of an open service order, starting the line
+// when it is pending or paused. A ConflictError is returned when the mechanic is already clocked
+// in, the order is closed or the line is finished, and sql.ErrNoRows when the mechanic, the order
+// or the line does not exist.
+func (store *SQLStore) ClockInTx(ctx context.Context, arg ClockInTxParams) (LaborEntry, error) {
+	var entry LaborEntry
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		mechanic, err := q.GetMechanic(ctx, arg.MechanicID)
+		if err != nil {
+			return err
+		}
+
+		clockedIn, err := q.GetClockedInLaborEntry(ctx, mechanic.ID)
+		if err == nil {
+			return conflictf("mechanic %s is clocked in since %s, clock out first",
+				mechanic.FullName, clockedIn.ClockedInAt.Format(time.RFC3339))
+		}
+		if err != sql.ErrNoRows {
+			return err
+		}
+
+		order, err := q.GetServiceOrderForUpdate(ctx, arg.ServiceOrderID)
+		if err != nil {
+			return err
+		}
+
+		if IsServiceOrderClosed(order.State) {
+			return conflictf("service order %d is %s", order.ID, ServiceOrderStateName(order.State))
+		}
+
+		detail, err := q.GetServiceDetailForUpdate(ctx, GetServiceDetailForUpdateParams{
+			ServiceOrderID: order.ID,
+			ServiceID:      arg.ServiceID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if detail.State != ServiceDetailStarted {
+			if !CanTransitionServiceDetail(detail.State, ServiceDetailStarted) {
+				return conflictf("service line is %s", ServiceDetailStateName(detail.State))
+			}
+			_, err = q.UpdateServiceDetailState(ctx, serviceDetailStateParams(detail, ServiceDetailStarted, time.Now()))
+			if err != nil {
+				return err
+			}
+		}
+
+		entry, err = q.CreateLaborEntry(ctx, CreateLaborEntryParams{
+			MechanicID:      mechanic.ID,
+			ServiceDetailID: detail.ID,
+		})
+		return err
+	})
+
+	return entry, err
+}
@@ -0,0 +1,308 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+// The operators a Condition compares its column with
+const (
+	OpEq  = "="
+	OpLt  = "<"
+	OpLte = "<="
+	OpGte = ">="
+	// OpPrefix matches the text columns starting with the value, ignoring case
+	OpPrefix = "prefix"
+	// opIsNull matches the NULL columns, it has no value
+	opIsNull = "is null"
+)
+
+// Condition is a filter of a list query, a column compared to a value
+type Condition struct {
+	Column string
+	Op     string
+	Value  interface{}
+}
+
+// Keyset is the position of the last row of the previous page, the value of the sort column
+// and the id of the row
+type Keyset struct {
+	Value string
+	ID    int64
+}
+
+// ListParams are the filters, the order and the page of a list query built at runtime. The
+// column names are quoted and the values sent as parameters, so neither can change the query.
+type ListParams struct {
+	Where []Condition
+	// The column to sort by, the id when empty. The id breaks the ties of other columns, which
+	// must be NOT NULL for the pages to follow each other.
+	SortColumn string
+	Desc       bool
+	// The row the page starts after, nil for the first page
+	After *Keyset
+	Limit int32
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// queryArgs collects the parameters of a query built at runtime
+type queryArgs []interface{}
+
+// add appends a parameter and returns its placeholder
+func (args *queryArgs) add(value interface{}) string {
+	*args = append(*args, value)
+	return "$" + strconv.Itoa(len(*args))
+}
+
+func buildWhere(where []Condition, args *queryArgs) ([]string, error) {
+	conditions := make([]string, 0, len(where))
+	for _, cond := range where {
+		column := pq.QuoteIdentifier(cond.Column)
+		switch cond.Op {
+		case OpEq, OpLt, OpLte, OpGte:
+			conditions = append(conditions, fmt.Sprintf("%s %s %s", column, cond.Op, args.add(cond.Value)))
+		case OpPrefix:
+			prefix, ok := cond.Value.(string)
+			if !ok {
+				return nil, fmt.Errorf("prefix of %s must be a string", cond.Column)
+			}
+			conditions = append(conditions, fmt.Sprintf("%s ILIKE %s", column, args.add(likeEscaper.Replace(prefix)+"%")))
+		case opIsNull:
+			conditions = append(conditions, column+" IS NULL")
+		default:
+			return nil, fmt.Errorf("unsupported operator %q", cond.Op)
+		}
+	}
+	return conditions, nil
+}
+
+// buildListQuery builds the query selecting a page of the columns of a table
+func buildListQuery(table string, columns string, arg ListParams) (string, []interface{}, error) {
+	var args queryArgs
+	conditions, err := buildWhere(arg.Where, &args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	order, cmp := "ASC", ">"
+	if arg.Desc {
+		order, cmp = "DESC", "<"
+	}
+
+	sort := ""
+	if arg.SortColumn != "" && arg.SortColumn != "id" {
+		sort = pq.QuoteIdentifier(arg.SortColumn)
+	}
+
+	if arg.After != nil {
+		if sort == "" {
+			conditions = append(conditions, fmt.Sprintf("id %s %s", cmp, args.add(arg.After.ID)))
+		} else {
+			value := args.add(arg.After.Value)
+			conditions = append(conditions, fmt.Sprintf("(%s %s %s OR (%s = %s AND id %s %s))",
+				sort, cmp, value, sort, value, cmp, args.add(arg.After.ID)))
+		}
+	}
+
+	var query strings.Builder
+	fmt.Fprintf(&query, "SELECT %s FROM %s", columns, pq.QuoteIdentifier(table))
+	if len(conditions) > 0 {
+		fmt.Fprintf(&query, "\nWHERE %s", strings.Join(conditions, " AND "))
+	}
+	if sort == "" {
+		fmt.Fprintf(&query, "\nORDER BY id %s", order)
+	} else {
+		fmt.Fprintf(&query, "\nORDER BY %s %s, id %s", sort, order, order)
+	}
+	fmt.Fprintf(&query, "\nLIMIT %s", args.add(arg.Limit))
+	return query.String(), args, nil
+}
+
+// buildCountQuery builds the query counting the rows of a table matching the filters
+func buildCountQuery(table string, where []Condition) (string, []interface{}, error) {
+	var args queryArgs
+	conditions, err := buildWhere(where, &args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	query := "SELECT count(*) FROM " + pq.QuoteIdentifier(table)
+	if len(conditions) > 0 {
+		query += "\nWHERE " + strings.Join(conditions, " AND ")
+	}
+	return query, args, nil
+}
+
+// notDeleted hides the soft deleted customers and cars, like the queries listing them
+func notDeleted(where []Condition) []Condition {
+	return append([]Condition{{Column: "deleted_at", Op: opIsNull}}, where...)
+}
+
+func (q *Queries) countWhere(ctx context.Context, table string, where []Condition) (int64, error) {
+	query, args, err := buildCountQuery(table, where)
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	err = q.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	return count, err
+}
+
+// ListCustomersWhere lists a page of the customers matching the filters
+func (q *Queries) ListCustomersWhere(ctx context.Context, arg ListParams) ([]Customer, error) {
+	arg.Where = notDeleted(arg.Where)
+	query, args, err := buildListQuery("customers", "id, full_name, phone_number, created_at, version, deleted_at", arg)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := q.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Customer{}
+	for rows.Next() {
+		var i Customer
+		if err := rows.Scan(
+			&i.ID,
+			&i.FullName,
+			&i.PhoneNumber,
+			&i.CreatedAt,
+			&i.Version,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	return items, rows.Err()
+}
+
+// CountCustomersWhere counts the customers matching the filters
+func (q *Queries) CountCustomersWhere(ctx context.Context, where []Condition) (int64, error) {
+	return q.countWhere(ctx, "customers", notDeleted(where))
+}
+
+// ListCarsWhere lists a page of the cars matching the filters
+func (q *Queries) ListCarsWhere(ctx context.Context, arg ListParams) ([]Car, error) {
+	arg.Where = notDeleted(arg.Where)
+	query, args, err := buildListQuery("cars", "id, customer_id, registraion_number, make, model, year, energy, version, deleted_at", arg)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := q.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Car{}
+	for rows.Next() {
+		var i Car
+		if err := rows.Scan(
+			&i.ID,
+			&i.CustomerID,
+			&i.RegistraionNumber,
+			&i.Make,
+			&i.Model,
+			&i.Year,
+			&i.Energy,
+			&i.Version,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	return items, rows.Err()
+}
+
+// CountCarsWhere counts the cars matching the filters
+func (q *Queries) CountCarsWhere(ctx context.Context, where []Condition) (int64, error) {
+	return q.countWhere(ctx, "cars", notDeleted(where))
+}
+
+// ListServiceOrdersWhere lists a page of the service orders matching the filters
+func (q *Queries) ListServiceOrdersWhere(ctx context.Context, arg ListParams) ([]ServiceOrder, error) {
+	query, args, err := buildListQuery("service_orders", "id, car_id, description, date_received, date_returned, state, version, mileage", arg)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := q.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ServiceOrder{}
+	for rows.Next() {
+		var i ServiceOrder
+		if err := rows.Scan(
+			&i.ID,
+			&i.CarID,
+			&i.Description,
+			&i.DateReceived,
+			&i.DateReturned,
+			&i.State,
+			&i.Version,
+			&i.Mileage,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	return items, rows.Err()
+}
+
+// CountServiceOrdersWhere counts the service orders matching the filters
+func (q *Queries) CountServiceOrdersWhere(ctx context.Context, where []Condition) (int64, error) {
+	return q.countWhere(ctx, "service_orders", where)
+}
+
+// ListPartsWhere lists a page of the parts matching the filters
+func (q *Queries) ListPartsWhere(ctx context.Context, arg ListParams) ([]Part, error) {
+	query, args, err := buildListQuery("parts", "id, name, description, retail_price, reorder_level", arg)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := q.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Part{}
+	for rows.Next() {
+		var i Part
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.RetailPrice,
+			&i.ReorderLevel,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	return items, rows.Err()
+}
+
+// CountPartsWhere counts the parts matching the filters
+func (q *Queries) CountPartsWhere(ctx context.Context, where []Condition) (int64, error) {
+	return q.countWhere(ctx, "parts", where)
+}
@@ -0,0 +1,146 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildListQuery(t *testing.T) {
+	query, args, err := buildListQuery("customers", "id, full_name", ListParams{
+		Where: []Condition{
+			{Column: "full_name", Op: OpPrefix, Value: "50%_ka"},
+			{Column: "created_at", Op: OpGte, Value: "2022-01-01"},
+		},
+		SortColumn: "full_name",
+		Desc:       true,
+		After:      &Keyset{Value: "karim", ID: 7},
+		Limit:      11,
+	})
+	require.NoError(t, err)
+	require.Equal(t, `SELECT id, full_name FROM "customers"
+WHERE "full_name" ILIKE $1 AND "created_at" >= $2 AND ("full_name" < $3 OR ("full_name" = $3 AND id < $4))
+ORDER BY "full_name" DESC, id DESC
+LIMIT $5`, query)
+	require.Equal(t, []interface{}{`50\%\_ka%`, "2022-01-01", "karim", int64(7), int32(11)}, args)
+
+	query, args, err = buildListQuery("parts", "id", ListParams{After: &Keyset{ID: 3}, Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, "SELECT id FROM \"parts\"\nWHERE id > $1\nORDER BY id ASC\nLIMIT $2", query)
+	require.Equal(t, []interface{}{int64(3), int32(2)}, args)
+
+	query, args, err = buildCountQuery("parts", nil)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT count(*) FROM "parts"`, query)
+	require.Empty(t, args)
+
+	_, _, err = buildListQuery("parts", "id", ListParams{Where: []Condition{{Column: "name", Op: "; DROP TABLE parts", Value: 1}}})
+	require.Error(t, err)
+	_, _, err = buildCountQuery("parts", []Condition{{Column: "name", Op: OpPrefix, Value: 1}})
+	require.Error(t, err)
+}
+
+func TestListCustomersWhere(t *testing.T) {
+	prefix := util.RandomString(10)
+	var customers []Customer
+	for _, name := range []string{"b", "a", "b"} {
+		customer, err := testQueries.CreateCustomer(context.Background(), CreateCustomerParams{
+			FullName:    prefix + name,
+			PhoneNumber: util.RandomPhone(),
+		})
+		require.NoError(t, err)
+		customers = append(customers, customer)
+	}
+	where := []Condition{
+		{Column: "full_name", Op: OpPrefix, Value: prefix},
+		{Column: "created_at", Op: OpGte, Value: time.Now().Add(-time.Hour)},
+	}
+
+	count, err := testQueries.CountCustomersWhere(context.Background(), where)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), count)
+
+	// sorted by name descending then by id, the two "b" come first
+	arg := ListParams{Where: where, SortColumn: "full_name", Desc: true, Limit: 2}
+	page1, err := testQueries.ListCustomersWhere(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, page1, 2)
+	require.Equal(t, customers[2].ID, page1[0].ID)
+	require.Equal(t, customers[0].ID, page1[1].ID)
+
+	arg.After = &Keyset{Value: page1[1].FullName, ID: page1[1].ID}
+	page2, err := testQueries.ListCustomersWhere(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, page2, 1)
+	require.Equal(t, customers[1].ID, page2[0].ID)
+}
+
+func TestListCarsWhere(t *testing.T) {
+	customer := createRandomCustomer(t)
+	var cars []Car
+	for i := 0; i < 3; i++ {
+		cars = append(cars, createRandomCar(t, customer))
+	}
+	where := []Condition{{Column: "customer_id", Op: OpEq, Value: customer.ID}}
+
+	count, err := testQueries.CountCarsWhere(context.Background(), where)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), count)
+
+	arg := ListParams{Where: where, Limit: 2}
+	page1, err := testQueries.ListCarsWhere(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, page1, 2)
+	require.Equal(t, cars[0].ID, page1[0].ID)
+	require.Equal(t, cars[1].ID, page1[1].ID)
+
+	arg.After = &Keyset{ID: int64(page1[1].ID)}
+	page2, err := testQueries.ListCarsWhere(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, page2, 1)
+	require.Equal(t, cars[2].ID, page2[0].ID)
+}
+
+func TestListPartsWhere(t *testing.T) {
+	var parts []Part
+	for i := 0; i < 3; i++ {
+		parts = append(parts, createRandomPart(t))
+	}
+	where := []Condition{
+		{Column: "id", Op: OpGte, Value: parts[0].ID},
+		{Column: "id", Op: OpLte, Value: parts[2].ID},
+	}
+
+	count, err := testQueries.CountPartsWhere(context.Background(), where)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), count)
+
+	list, err := testQueries.ListPartsWhere(context.Background(), ListParams{Where: where, Desc: true, Limit: 5})
+	require.NoError(t, err)
+	require.Len(t, list, 3)
+	require.Equal(t, parts[2].ID, list[0].ID)
+	require.Equal(t, parts[0].ID, list[2].ID)
+}
+
+func TestListServiceOrdersWhere(t *testing.T) {
+	car := createRandomCar(t, createRandomCustomer(t))
+	var orders []ServiceOrder
+	for i := 0; i < 3; i++ {
+		orders = append(orders, createRandomServiceOrder(t, car))
+	}
+	where := []Condition{{Column: "car_id", Op: OpEq, Value: car.ID}}
+
+	count, err := testQueries.CountServiceOrdersWhere(context.Background(), where)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), count)
+
+	list, err := testQueries.ListServiceOrdersWhere(context.Background(), ListParams{Where: where, Limit: 5})
+	require.NoError(t, err)
+	require.Len(t, list, 3)
+	for i, order := range list {
+		require.Equal(t, orders[i].ID, order.ID)
+	}
+}
@@ -0,0 +1,137 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomMechanic(t *testing.T) Mechanic {
+	fullName := util.RandomString(12)
+
+	mechanic, err := testQueries.CreateMechanic(context.Background(), fullName)
+	require.NoError(t, err)
+	require.NotEmpty(t, mechanic)
+
+	require.Equal(t, fullName, mechanic.FullName)
+	require.NotZero(t, mechanic.ID)
+
+	return mechanic
+}
+
+func TestCreateMechanic(t *testing.T) {
+	createRandomMechanic(t)
+}
+
+func TestGetMechanic(t *testing.T) {
+	mechanic1 := createRandomMechanic(t)
+	mechanic2, err := testQueries.GetMechanic(context.Background(), mechanic1.ID)
+	require.NoError(t, err)
+	require.Equal(t, mechanic1, mechanic2)
+}
+
+func TestUpdateMechanic(t *testing.T) {
+	mechanic1 := createRandomMechanic(t)
+
+	arg := UpdateMechanicParams{
+		ID:       mechanic1.ID,
+		FullName: util.RandomString(12),
+	}
+
+	mechanic2, err := testQueries.UpdateMechanic(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, mechanic1.ID, mechanic2.ID)
+	require.Equal(t, arg.FullName, mechanic2.FullName)
+}
+
+func TestDeleteMechanic(t *testing.T) {
+	mechanic1 := createRandomMechanic(t)
+	rows, err := testQueries.DeleteMechanic(context.Background(), mechanic1.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rows)
+
+	mechanic2, err := testQueries.GetMechanic(context.Background(), mechanic1.ID)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+	require.Empty(t, mechanic2)
+}
+
+func TestListMechanics(t *testing.T) {
+	for i := 0; i < 10; i++ {
+		createRandomMechanic(t)
+	}
+
+	arg := ListMechanicsParams{
+		Limit:   5,
+		AfterID: 0,
+	}
+
+	mechanics, err := testQueries.ListMechanics(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, mechanics, 5)
+}
+
+func TestAssignMechanic(t *testing.T) {
+	mechanic := createRandomMechanic(t)
+	order := createRandomServiceOrder(t, createRandomCar(t, createRandomCustomer(t)))
+
+	arg := AssignMechanicParams{
+		MechanicID:     mechanic.ID,
+		ServiceOrderID: order.ID,
+	}
+	detail, err := testQueries.AssignMechanic(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, detail.ID)
+	require.Equal(t, mechanic.ID, detail.MechanicID)
+	require.Equal(t, order.ID, detail.ServiceOrderID)
+
+	// a mechanic is assigned to an order only once
+	_, err = testQueries.AssignMechanic(context.Background(), arg)
+	require.Error(t, err)
+
+	mechanics, err := testQueries.ListServiceOrderMechanics(context.Background(), order.ID)
+	require.NoError(t, err)
+	require.Equal(t, []Mechanic{mechanic}, mechanics)
+
+	rows, err := testQueries.UnassignMechanic(context.Background(), UnassignMechanicParams{
+		ServiceOrderID: order.ID,
+		MechanicID:     mechanic.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rows)
+
+	mechanics, err = testQueries.ListServiceOrderMechanics(context.Background(), order.ID)
+	require.NoError(t, err)
+	require.Empty(t, mechanics)
+}
+
+func TestListMechanicWorkload(t *testing.T) {
+	mechanic := createRandomMechanic(t)
+	car := createRandomCar(t, createRandomCustomer(t))
+
+	open := createRandomServiceOrder(t, car)
+	cancelled := createRandomServiceOrder(t, car)
+	for _, order := range []ServiceOrder{open, cancelled} {
+		_, err := testQueries.AssignMechanic(context.Background(), AssignMechanicParams{
+			MechanicID:     mechanic.ID,
+			ServiceOrderID: order.ID,
+		})
+		require.NoError(t, err)
+	}
+
+	_, err := testQueries.UpdateServiceOrderState(context.Background(), UpdateServiceOrderStateParams{
+		ID:        cancelled.ID,
+		FromState: ServiceOrderOpen,
+		State:     ServiceOrderCancelled,
+	})
+	require.NoError(t, err)
+
+	orders, err := testQueries.ListMechanicWorkload(context.Background(), ListMechanicWorkloadParams{
+		MechanicID:   mechanic.ID,
+		ClosedStates: ClosedServiceOrderStates,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []ServiceOrder{open}, orders)
+}
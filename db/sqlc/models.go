@@ -1,29 +1,56 @@
 // Code generated by sqlc. DO NOT EDIT.
 // versions:
-//   sqlc v1.13.0
+//   sqlc v1.14.0
 
 package db
 
 import (
 	"database/sql"
 	"time"
+
+	"github.com/google/uuid"
 )
 
+type Appointment struct {
+	ID             int32         `json:"id"`
+	CustomerID     int64         `json:"customer_id"`
+	CarID          int32         `json:"car_id"`
+	Bay            int32         `json:"bay"`
+	StartsAt       time.Time     `json:"starts_at"`
+	EndsAt         time.Time     `json:"ends_at"`
+	Notes          string        `json:"notes"`
+	State          int32         `json:"state"`
+	ServiceOrderID sql.NullInt32 `json:"service_order_id"`
+	CreatedAt      time.Time     `json:"created_at"`
+}
+
 type Car struct {
-	ID                int32  `json:"id"`
-	CustomerID        int32  `json:"customer_id"`
-	RegistraionNumber string `json:"registraion_number"`
-	Make              string `json:"make"`
-	Model             string `json:"model"`
-	Year              string `json:"year"`
-	Energy            string `json:"energy"`
+	ID                int32        `json:"id"`
+	CustomerID        int32        `json:"customer_id"`
+	RegistraionNumber string       `json:"registraion_number"`
+	Make              string       `json:"make"`
+	Model             string       `json:"model"`
+	Year              string       `json:"year"`
+	Energy            string       `json:"energy"`
+	Version           int32        `json:"version"`
+	DeletedAt         sql.NullTime `json:"deleted_at"`
 }
 
 type Customer struct {
-	ID          int64     `json:"id"`
-	FullName    string    `json:"full_name"`
-	PhoneNumber string    `json:"phone_number"`
-	CreatedAt   time.Time `json:"created_at"`
+	ID          int64        `json:"id"`
+	FullName    string       `json:"full_name"`
+	PhoneNumber string       `json:"phone_number"`
+	CreatedAt   time.Time    `json:"created_at"`
+	Version     int32        `json:"version"`
+	DeletedAt   sql.NullTime `json:"deleted_at"`
+}
+
+type LaborEntry struct {
+	ID              int32        `json:"id"`
+	MechanicID      int32        `json:"mechanic_id"`
+	ServiceDetailID int32        `json:"service_detail_id"`
+	ClockedInAt     time.Time    `json:"clocked_in_at"`
+	ClockedOutAt    sql.NullTime `json:"clocked_out_at"`
 }
 
 type Mechanic struct {
@@ -38,10 +65,11 @@ type MechanicDetail struct {
 }
 
 type Part struct {
-	ID          int32  `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	RetailPrice string `json:"retail_price"`
+	ID           int32  `json:"id"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	RetailPrice  string `json:"retail_price"`
+	ReorderLevel int32  `json:"reorder_level"`
 }
 
 type PartDetail struct {
@@ -52,11 +80,20 @@ type PartDetail struct {
 	Price          sql.NullString `json:"price"`
 }
 
+type PartStock struct {
+	PartID       int32  `json:"part_id"`
+	Name         string `json:"name"`
+	ReorderLevel int32  `json:"reorder_level"`
+	Purchased    int64  `json:"purchased"`
+	Consumed     int64  `json:"consumed"`
+	OnHand       int64  `json:"on_hand"`
+}
+
 type Payment struct {
-	ID            int32          `json:"id"`
-	SaleInvoiceID int32          `json:"sale_invoice_id"`
-	Amount        sql.NullString `json:"amount"`
-	Date          sql.NullTime   `json:"date"`
+	ID            int32     `json:"id"`
+	SaleInvoiceID int32     `json:"sale_invoice_id"`
+	Amount        string    `json:"amount"`
+	Date          time.Time `json:"date"`
 }
 
 type PurchaseDetail struct {
@@ -72,6 +109,7 @@ type PurchaseInvoice struct {
 	SupplierID int32        `json:"supplier_id"`
 	Ref        string       `json:"ref"`
 	Date       sql.NullTime `json:"date"`
+	Total      string       `json:"total"`
 }
 
 type SaleInvoice struct {
@@ -79,6 +117,30 @@ type SaleInvoice struct {
 	ServiceOrderID int32        `json:"service_order_id"`
 	Date           sql.NullTime `json:"date"`
 	Ref            string       `json:"ref"`
+	Total          string       `json:"total"`
+}
+
+type SaleInvoiceBalance struct {
+	SaleInvoiceID  int32        `json:"sale_invoice_id"`
+	ServiceOrderID int32        `json:"service_order_id"`
+	Ref            string       `json:"ref"`
+	Date           sql.NullTime `json:"date"`
+	Total          string       `json:"total"`
+	Paid           string       `json:"paid"`
+	Outstanding    string       `json:"outstanding"`
+	Status         string       `json:"status"`
+	AgeDays        int32        `json:"age_days"`
+	AgingBucket    string       `json:"aging_bucket"`
+}
+
+type SaleInvoiceLine struct {
+	ID            int32  `json:"id"`
+	SaleInvoiceID int32  `json:"sale_invoice_id"`
+	Kind          string `json:"kind"`
+	Description   string `json:"description"`
+	Quantity      int32  `json:"quantity"`
+	UnitPrice     string `json:"unit_price"`
+	Amount        string `json:"amount"`
 }
 
 type Service struct {
@@ -91,11 +153,15 @@ type Service struct {
 }
 
 type ServiceDetail struct {
-	ID             int32          `json:"id"`
-	ServiceID      int32          `json:"service_id"`
-	ServiceOrderID int32          `json:"service_order_id"`
-	Price          sql.NullString `json:"price"`
-	State          int32          `json:"state"`
+	ID                  int32          `json:"id"`
+	ServiceID           int32          `json:"service_id"`
+	ServiceOrderID      int32          `json:"service_order_id"`
+	Price               sql.NullString `json:"price"`
+	State               int32          `json:"state"`
+	PriceOverrideReason sql.NullString `json:"price_override_reason"`
+	PriceOverriddenBy   sql.NullString `json:"price_overridden_by"`
+	StartedAt           sql.NullTime   `json:"started_at"`
+	StoppedAt           sql.NullTime   `json:"stopped_at"`
 }
 
 type ServiceOrder struct {
@@ -105,6 +171,19 @@ type ServiceOrder struct {
 	DateReceived sql.NullTime   `json:"date_received"`
 	DateReturned sql.NullTime   `json:"date_returned"`
 	State        int32          `json:"state"`
+	Version      int32          `json:"version"`
+	Mileage      sql.NullInt32  `json:"mileage"`
+}
+
+type Session struct {
+	ID           uuid.UUID `json:"id"`
+	Username     string    `json:"username"`
+	RefreshToken string    `json:"refresh_token"`
+	UserAgent    string    `json:"user_agent"`
+	ClientIp     string    `json:"client_ip"`
+	IsBlocked    bool      `json:"is_blocked"`
+	ExpiresAt    time.Time `json:"expires_at"`
+	CreatedAt    time.Time `json:"created_at"`
 }
 
 type Supplier struct {
@@ -113,3 +192,12 @@ type Supplier struct {
 	Address     sql.NullString `json:"address"`
 	PhoneNumber string         `json:"phone_number"`
 }
+
+type User struct {
+	Username          string    `json:"username"`
+	HashedPassword    string    `json:"hashed_password"`
+	FullName          string    `json:"full_name"`
+	Role              string    `json:"role"`
+	PasswordChangedAt time.Time `json:"password_changed_at"`
+	CreatedAt         time.Time `json:"created_at"`
+}
@@ -0,0 +1,67 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomPartDetail(t *testing.T, part Part, order ServiceOrder) PartDetail {
+
+	arg := CreatePartDetailParams{
+		PartID:         part.ID,
+		ServiceOrderID: order.ID,
+		Quantity:       int32(util.RandomInt(1, 5)),
+		Price:          sql.NullString{String: part.RetailPrice, Valid: true},
+	}
+
+	detail, err := testQueries.CreatePartDetail(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, detail)
+
+	require.Equal(t, arg.PartID, detail.PartID)
+	require.Equal(t, arg.ServiceOrderID, detail.ServiceOrderID)
+	require.Equal(t, arg.Quantity, detail.Quantity)
+	require.Equal(t, arg.Price, detail.Price)
+
+	require.NotZero(t, detail.ID)
+
+	return detail
+}
+
+func TestCreatePartDetail(t *testing.T) {
+	order := createRandomServiceOrder(t, createRandomCar(t, createRandomCustomer(t)))
+	createRandomPartDetail(t, createRandomPart(t), order)
+}
+
+func TestListServiceOrderParts(t *testing.T) {
+	order := createRandomServiceOrder(t, createRandomCar(t, createRandomCustomer(t)))
+	var details []PartDetail
+	for i := 0; i < 3; i++ {
+		details = append(details, createRandomPartDetail(t, createRandomPart(t), order))
+	}
+
+	details2, err := testQueries.ListServiceOrderParts(context.Background(), order.ID)
+	require.NoError(t, err)
+	require.Equal(t, details, details2)
+}
+
+func TestDeletePartDetail(t *testing.T) {
+	order := createRandomServiceOrder(t, createRandomCar(t, createRandomCustomer(t)))
+	detail := createRandomPartDetail(t, createRandomPart(t), order)
+
+	arg := DeletePartDetailParams{
+		ServiceOrderID: order.ID,
+		PartID:         detail.PartID,
+	}
+	rows, err := testQueries.DeletePartDetail(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rows)
+
+	rows, err = testQueries.DeletePartDetail(context.Background(), arg)
+	require.NoError(t, err)
+	require.Zero(t, rows)
+}
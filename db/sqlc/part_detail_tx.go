@@ -0,0 +1,96 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+// AddPartDetailTxParams contains the input parameters of the part consumption transaction
+type AddPartDetailTxParams struct {
+	ServiceOrderID int32 `json:"service_order_id"`
+	PartID         int32 `json:"part_id"`
+	Quantity       int32 `json:"quantity"`
+	// Price is the unit price charged, the retail price of the part when empty
+	Price string `json:"price"`
+}
+
+// AddPartDetailTx uses a part on an open service order. The part row is locked so concurrent
+// requests for the same part cannot both pass the stock check and take the stock negative.
+// A ConflictError is returned when the order is closed or invoiced or there is not enough
+// stock, and sql.ErrNoRows when the order or the part does not exist.
+func (store *SQLStore) AddPartDetailTx(ctx context.Context, arg AddPartDetailTxParams) (PartDetail, error) {
+	var detail PartDetail
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		order, err := q.GetServiceOrderForUpdate(ctx, arg.ServiceOrderID)
+		if err != nil {
+			return err
+		}
+
+		if IsServiceOrderClosed(order.State) {
+			return conflictf("service order %d is %s", order.ID, ServiceOrderStateName(order.State))
+		}
+
+		if err := checkNotInvoiced(ctx, q, order.ID); err != nil {
+			return err
+		}
+
+		part, err := q.GetPartForUpdate(ctx, arg.PartID)
+		if err != nil {
+			return err
+		}
+
+		stock, err := q.GetPartStock(ctx, part.ID)
+		if err != nil {
+			return err
+		}
+
+		if stock.OnHand < int64(arg.Quantity) {
+			return conflictf("not enough %s in stock: %d on hand, %d requested", part.Name, stock.OnHand, arg.Quantity)
+		}
+
+		price := arg.Price
+		if price == "" {
+			price = part.RetailPrice
+		}
+
+		detail, err = q.CreatePartDetail(ctx, CreatePartDetailParams{
+			PartID:         part.ID,
+			ServiceOrderID: order.ID,
+			Quantity:       arg.Quantity,
+			Price:          sql.NullString{String: price, Valid: true},
+		})
+		return err
+	})
+
+	return detail, err
+}
+
+// DeletePartDetailTx removes a part from an open service order that was not invoiced, the part
+// goes back to stock. A ConflictError is returned when the order is closed or invoiced, and
+// sql.ErrNoRows when the order or its line of the part does not exist.
+func (store *SQLStore) DeletePartDetailTx(ctx context.Context, arg DeletePartDetailParams) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		order, err := q.GetServiceOrderForUpdate(ctx, arg.ServiceOrderID)
+		if err != nil {
+			return err
+		}
+
+		if IsServiceOrderClosed(order.State) {
+			return conflictf("service order %d is %s", order.ID, ServiceOrderStateName(order.State))
+		}
+
+		if err := checkNotInvoiced(ctx, q, order.ID); err != nil {
+			return err
+		}
+
+		rows, err := q.DeletePartDetail(ctx, arg)
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return sql.ErrNoRows
+		}
+		return nil
+	})
+}
@@ -0,0 +1,108 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomPart(t *testing.T) Part {
+
+	arg := CreatePartParams{
+		Name:         util.RandomString(12),
+		Description:  util.RandomString(30),
+		RetailPrice:  util.RandomPrice(),
+		ReorderLevel: int32(util.RandomInt(0, 10)),
+	}
+
+	part, err := testQueries.CreatePart(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, part)
+
+	require.Equal(t, arg.Name, part.Name)
+	require.Equal(t, arg.Description, part.Description)
+	require.Equal(t, arg.RetailPrice, part.RetailPrice)
+	require.Equal(t, arg.ReorderLevel, part.ReorderLevel)
+
+	require.NotZero(t, part.ID)
+
+	return part
+}
+
+func TestCreatePart(t *testing.T) {
+	createRandomPart(t)
+}
+
+func TestGetPart(t *testing.T) {
+	part1 := createRandomPart(t)
+	part2, err := testQueries.GetPart(context.Background(), part1.ID)
+	require.NoError(t, err)
+	require.Equal(t, part1, part2)
+}
+
+func TestUpdatePart(t *testing.T) {
+	part1 := createRandomPart(t)
+
+	arg := UpdatePartParams{
+		ID:           part1.ID,
+		Name:         part1.Name,
+		Description:  util.RandomString(30),
+		RetailPrice:  util.RandomPrice(),
+		ReorderLevel: part1.ReorderLevel + 1,
+	}
+
+	part2, err := testQueries.UpdatePart(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, part1.ID, part2.ID)
+	require.Equal(t, arg.Description, part2.Description)
+	require.Equal(t, arg.RetailPrice, part2.RetailPrice)
+	require.Equal(t, arg.ReorderLevel, part2.ReorderLevel)
+}
+
+func TestDeletePart(t *testing.T) {
+	part1 := createRandomPart(t)
+	rows, err := testQueries.DeletePart(context.Background(), part1.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rows)
+
+	part2, err := testQueries.GetPart(context.Background(), part1.ID)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+	require.Empty(t, part2)
+}
+
+func TestGetPartStock(t *testing.T) {
+	part := createRandomPart(t)
+
+	stock, err := testQueries.GetPartStock(context.Background(), part.ID)
+	require.NoError(t, err)
+	require.Equal(t, part.ID, stock.PartID)
+	require.Equal(t, part.Name, stock.Name)
+	require.Zero(t, stock.Purchased)
+	require.Zero(t, stock.Consumed)
+	require.Zero(t, stock.OnHand)
+
+	order := createRandomServiceOrder(t, createRandomCar(t, createRandomCustomer(t)))
+	createRandomPartDetail(t, part, order)
+
+	stock2, err := testQueries.GetPartStock(context.Background(), part.ID)
+	require.NoError(t, err)
+	require.NotZero(t, stock2.Consumed)
+	require.Equal(t, stock2.Purchased-stock2.Consumed, stock2.OnHand)
+}
+
+func TestListLowStockParts(t *testing.T) {
+	part := createRandomPart(t)
+
+	stocks, err := testQueries.ListLowStockParts(context.Background())
+	require.NoError(t, err)
+
+	found := false
+	for _, stock := range stocks {
+		require.LessOrEqual(t, stock.OnHand, int64(stock.ReorderLevel))
+		found = found || stock.PartID == part.ID
+	}
+	require.True(t, found)
+}
@@ -0,0 +1,129 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// createTotalledSaleInvoice issues an invoice of 100.00 for a new service order
+func createTotalledSaleInvoice(t *testing.T) SaleInvoice {
+	order := createRandomServiceOrder(t, createRandomCar(t, createRandomCustomer(t)))
+
+	_, err := testQueries.CreatePartDetail(context.Background(), CreatePartDetailParams{
+		PartID:         createRandomPart(t).ID,
+		ServiceOrderID: order.ID,
+		Quantity:       2,
+		Price:          sql.NullString{String: "50.00", Valid: true},
+	})
+	require.NoError(t, err)
+
+	invoice := createRandomSaleInvoice(t, order)
+	err = testQueries.CreateSaleInvoicePartLines(context.Background(), CreateSaleInvoicePartLinesParams{
+		SaleInvoiceID:  invoice.ID,
+		ServiceOrderID: order.ID,
+	})
+	require.NoError(t, err)
+
+	invoice, err = testQueries.UpdateSaleInvoiceTotal(context.Background(), invoice.ID)
+	require.NoError(t, err)
+	require.Equal(t, "100.00", invoice.Total)
+
+	return invoice
+}
+
+func createRandomPayment(t *testing.T, invoice SaleInvoice, amount string) Payment {
+	arg := CreatePaymentParams{
+		SaleInvoiceID: invoice.ID,
+		Amount:        amount,
+		Date:          time.Now().Truncate(24 * time.Hour),
+	}
+
+	payment, err := testQueries.CreatePayment(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, payment)
+
+	require.Equal(t, arg.SaleInvoiceID, payment.SaleInvoiceID)
+	require.Equal(t, arg.Amount, payment.Amount)
+	require.WithinDuration(t, arg.Date, payment.Date, 24*time.Hour)
+
+	require.NotZero(t, payment.ID)
+
+	return payment
+}
+
+func requireBalance(t *testing.T, invoice SaleInvoice, paid, outstanding, status string) {
+	balance, err := testQueries.GetSaleInvoiceBalance(context.Background(), invoice.ID)
+	require.NoError(t, err)
+	require.Equal(t, invoice.Total, balance.Total)
+	require.Equal(t, paid, balance.Paid)
+	require.Equal(t, outstanding, balance.Outstanding)
+	require.Equal(t, status, balance.Status)
+}
+
+func TestCreatePayment(t *testing.T) {
+	invoice := createTotalledSaleInvoice(t)
+	createRandomPayment(t, invoice, "40.00")
+
+	_, err := testQueries.CreatePayment(context.Background(), CreatePaymentParams{
+		SaleInvoiceID: invoice.ID,
+		Amount:        "-5.00",
+		Date:          time.Now(),
+	})
+	require.Error(t, err)
+}
+
+func TestSaleInvoiceBalance(t *testing.T) {
+	invoice := createTotalledSaleInvoice(t)
+	requireBalance(t, invoice, "0", "100.00", "unpaid")
+
+	payment := createRandomPayment(t, invoice, "40.00")
+	requireBalance(t, invoice, "40.00", "60.00", "partial")
+
+	createRandomPayment(t, invoice, "60.00")
+	requireBalance(t, invoice, "100.00", "0.00", "paid")
+
+	createRandomPayment(t, invoice, "5.00")
+	requireBalance(t, invoice, "105.00", "-5.00", "overpaid")
+
+	rows, err := testQueries.DeletePayment(context.Background(), DeletePaymentParams{
+		ID:            payment.ID,
+		SaleInvoiceID: invoice.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rows)
+	requireBalance(t, invoice, "65.00", "35.00", "partial")
+
+	payments, err := testQueries.ListInvoicePayments(context.Background(), invoice.ID)
+	require.NoError(t, err)
+	require.Len(t, payments, 2)
+}
+
+func TestListOutstandingInvoices(t *testing.T) {
+	unpaid := createTotalledSaleInvoice(t)
+	paid := createTotalledSaleInvoice(t)
+	createRandomPayment(t, paid, "100.00")
+
+	invoices, err := testQueries.ListOutstandingInvoices(context.Background())
+	require.NoError(t, err)
+
+	var found bool
+	for _, invoice := range invoices {
+		require.NotEqual(t, paid.ID, invoice.SaleInvoiceID)
+		if invoice.SaleInvoiceID == unpaid.ID {
+			found = true
+			require.Equal(t, int32(0), invoice.AgeDays)
+			require.Equal(t, "0-30", invoice.AgingBucket)
+		}
+	}
+	require.True(t, found)
+
+	buckets, err := testQueries.ListOutstandingInvoiceAging(context.Background())
+	require.NoError(t, err)
+	require.NotEmpty(t, buckets)
+	require.Equal(t, "0-30", buckets[0].AgingBucket)
+	require.NotZero(t, buckets[0].Invoices)
+}
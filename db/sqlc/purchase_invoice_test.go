@@ -0,0 +1,163 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomPurchaseInvoice(t *testing.T, supplier Supplier) PurchaseInvoice {
+
+	arg := CreatePurchaseInvoiceParams{
+		SupplierID: supplier.ID,
+		Ref:        util.RandomString(8),
+		Date:       sql.NullTime{Time: time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+	}
+
+	invoice, err := testQueries.CreatePurchaseInvoice(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, invoice)
+
+	require.Equal(t, arg.SupplierID, invoice.SupplierID)
+	require.Equal(t, arg.Ref, invoice.Ref)
+	require.True(t, arg.Date.Time.Equal(invoice.Date.Time))
+	require.Equal(t, "0", invoice.Total)
+
+	require.NotZero(t, invoice.ID)
+
+	return invoice
+}
+
+func TestCreatePurchaseInvoice(t *testing.T) {
+	createRandomPurchaseInvoice(t, createRandomSupplier(t))
+}
+
+func TestGetPurchaseInvoice(t *testing.T) {
+	invoice1 := createRandomPurchaseInvoice(t, createRandomSupplier(t))
+	invoice2, err := testQueries.GetPurchaseInvoice(context.Background(), invoice1.ID)
+	require.NoError(t, err)
+	require.Equal(t, invoice1.ID, invoice2.ID)
+	require.Equal(t, invoice1.Ref, invoice2.Ref)
+	require.Equal(t, invoice1.Total, invoice2.Total)
+}
+
+func TestUpdatePurchaseInvoice(t *testing.T) {
+	invoice1 := createRandomPurchaseInvoice(t, createRandomSupplier(t))
+
+	arg := UpdatePurchaseInvoiceParams{
+		ID:         invoice1.ID,
+		SupplierID: createRandomSupplier(t).ID,
+		Ref:        util.RandomString(8),
+	}
+
+	invoice2, err := testQueries.UpdatePurchaseInvoice(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.SupplierID, invoice2.SupplierID)
+	require.Equal(t, arg.Ref, invoice2.Ref)
+	require.False(t, invoice2.Date.Valid)
+}
+
+func TestDeletePurchaseInvoice(t *testing.T) {
+	invoice1 := createRandomPurchaseInvoice(t, createRandomSupplier(t))
+	rows, err := testQueries.DeletePurchaseInvoice(context.Background(), invoice1.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rows)
+
+	_, err = testQueries.GetPurchaseInvoice(context.Background(), invoice1.ID)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+}
+
+func TestListPurchaseInvoices(t *testing.T) {
+	supplier := createRandomSupplier(t)
+	for i := 0; i < 10; i++ {
+		createRandomPurchaseInvoice(t, supplier)
+	}
+
+	arg := ListPurchaseInvoicesParams{
+		Limit:   5,
+		AfterID: 0,
+	}
+
+	invoices, err := testQueries.ListPurchaseInvoices(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, invoices, 5)
+}
+
+func requirePurchaseInvoiceTotal(t *testing.T, id int32, total string) {
+	invoice, err := testQueries.GetPurchaseInvoice(context.Background(), id)
+	require.NoError(t, err)
+	require.Equal(t, total, invoice.Total)
+}
+
+func TestPurchaseInvoiceTotal(t *testing.T) {
+	invoice := createRandomPurchaseInvoice(t, createRandomSupplier(t))
+
+	line1, err := testQueries.CreatePurchaseDetail(context.Background(), CreatePurchaseDetailParams{
+		PartID:            createRandomPart(t).ID,
+		PurchaseInvoiceID: invoice.ID,
+		Quantity:          2,
+		Price:             "10.50",
+	})
+	require.NoError(t, err)
+	requirePurchaseInvoiceTotal(t, invoice.ID, "21.00")
+
+	line2, err := testQueries.CreatePurchaseDetail(context.Background(), CreatePurchaseDetailParams{
+		PartID:            createRandomPart(t).ID,
+		PurchaseInvoiceID: invoice.ID,
+		Quantity:          4,
+		Price:             "3.25",
+	})
+	require.NoError(t, err)
+	requirePurchaseInvoiceTotal(t, invoice.ID, "34.00")
+
+	lines, err := testQueries.ListPurchaseDetails(context.Background(), invoice.ID)
+	require.NoError(t, err)
+	require.Equal(t, []PurchaseDetail{line1, line2}, lines)
+
+	_, err = testQueries.UpdatePurchaseDetail(context.Background(), UpdatePurchaseDetailParams{
+		ID:                line1.ID,
+		PurchaseInvoiceID: invoice.ID,
+		PartID:            line1.PartID,
+		Quantity:          1,
+		Price:             line1.Price,
+	})
+	require.NoError(t, err)
+	requirePurchaseInvoiceTotal(t, invoice.ID, "23.50")
+
+	rows, err := testQueries.DeletePurchaseDetail(context.Background(), DeletePurchaseDetailParams{
+		ID:                line2.ID,
+		PurchaseInvoiceID: invoice.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rows)
+	requirePurchaseInvoiceTotal(t, invoice.ID, "10.50")
+
+	_, err = testQueries.DeletePurchaseDetail(context.Background(), DeletePurchaseDetailParams{
+		ID:                line1.ID,
+		PurchaseInvoiceID: invoice.ID,
+	})
+	require.NoError(t, err)
+	requirePurchaseInvoiceTotal(t, invoice.ID, "0")
+}
+
+func TestPurchasedPartsAreInStock(t *testing.T) {
+	part := createRandomPart(t)
+	invoice := createRandomPurchaseInvoice(t, createRandomSupplier(t))
+
+	_, err := testQueries.CreatePurchaseDetail(context.Background(), CreatePurchaseDetailParams{
+		PartID:            part.ID,
+		PurchaseInvoiceID: invoice.ID,
+		Quantity:          10,
+		Price:             part.RetailPrice,
+	})
+	require.NoError(t, err)
+
+	stock, err := testQueries.GetPartStock(context.Background(), part.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), stock.Purchased)
+	require.Equal(t, int64(10), stock.OnHand)
+}
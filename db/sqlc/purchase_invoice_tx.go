@@ -0,0 +1,206 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+)
+
+// CreatePurchaseInvoiceTxParams contains the input parameters of the purchase invoice creation transaction
+type CreatePurchaseInvoiceTxParams struct {
+	SupplierID int32                        `json:"supplier_id"`
+	Ref        string                       `json:"ref"`
+	Date       sql.NullTime                 `json:"date"`
+	Lines      []CreatePurchaseDetailParams `json:"lines"`
+}
+
+// CreatePurchaseInvoiceTxResult is the result of the purchase invoice creation transaction
+type CreatePurchaseInvoiceTxResult struct {
+	Invoice PurchaseInvoice  `json:"invoice"`
+	Lines   []PurchaseDetail `json:"lines"`
+}
+
+// CreatePurchaseInvoiceTx records a purchase invoice with all its lines, or nothing if a line is refused.
+// The PurchaseInvoiceID of the lines is ignored.
+func (store *SQLStore) CreatePurchaseInvoiceTx(ctx context.Context, arg CreatePurchaseInvoiceTxParams) (CreatePurchaseInvoiceTxResult, error) {
+	var result CreatePurchaseInvoiceTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Invoice, err = q.CreatePurchaseInvoice(ctx, CreatePurchaseInvoiceParams{
+			SupplierID: arg.SupplierID,
+			Ref:        arg.Ref,
+			Date:       arg.Date,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, line := range arg.Lines {
+			line.PurchaseInvoiceID = result.Invoice.ID
+			if _, err := q.CreatePurchaseDetail(ctx, line); err != nil {
+				return err
+			}
+		}
+
+		// the total is maintained by a trigger on the lines
+		result.Invoice, err = q.GetPurchaseInvoice(ctx, result.Invoice.ID)
+		if err != nil {
+			return err
+		}
+
+		result.Lines, err = q.ListPurchaseDetails(ctx, result.Invoice.ID)
+		return err
+	})
+
+	return result, err
+}
+
+// AddPurchaseDetailTx adds a line to a purchase invoice. The invoice is locked so the line
+// cannot be added to an invoice being deleted. sql.ErrNoRows is returned when the invoice does
+// not exist.
+func (store *SQLStore) AddPurchaseDetailTx(ctx context.Context, arg CreatePurchaseDetailParams) (PurchaseDetail, error) {
+	var detail PurchaseDetail
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		if _, err := q.GetPurchaseInvoiceForUpdate(ctx, arg.PurchaseInvoiceID); err != nil {
+			return err
+		}
+
+		var err error
+		detail, err = q.CreatePurchaseDetail(ctx, arg)
+		return err
+	})
+
+	return detail, err
+}
+
+// UpdatePurchaseDetailTx updates a line of a purchase invoice. The invoice is locked first, then
+// the parts of the line before and after the update so a concurrent use of the stock cannot be
+// counted on purchases that are gone. A ConflictError is returned when lowering the quantity or
+// changing the part would take the stock on hand of the former part negative, and sql.ErrNoRows
+// when the line does not exist on the invoice.
+func (store *SQLStore) UpdatePurchaseDetailTx(ctx context.Context, arg UpdatePurchaseDetailParams) (PurchaseDetail, error) {
+	var detail PurchaseDetail
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		if _, err := q.GetPurchaseInvoiceForUpdate(ctx, arg.PurchaseInvoiceID); err != nil {
+			return err
+		}
+
+		line, err := q.GetPurchaseDetailForUpdate(ctx, GetPurchaseDetailForUpdateParams{
+			ID:                arg.ID,
+			PurchaseInvoiceID: arg.PurchaseInvoiceID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := lockParts(ctx, q, []int32{line.PartID, arg.PartID}); err != nil {
+			return err
+		}
+
+		detail, err = q.UpdatePurchaseDetail(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		return checkStockNotNegative(ctx, q, line.PartID)
+	})
+
+	return detail, err
+}
+
+// DeletePurchaseDetailTx removes a line from a purchase invoice, locking the invoice first. A
+// ConflictError is returned when the parts of the line were already used, so removing it would
+// take the stock on hand negative, and sql.ErrNoRows when the line does not exist on the invoice.
+func (store *SQLStore) DeletePurchaseDetailTx(ctx context.Context, arg DeletePurchaseDetailParams) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		if _, err := q.GetPurchaseInvoiceForUpdate(ctx, arg.PurchaseInvoiceID); err != nil {
+			return err
+		}
+
+		line, err := q.GetPurchaseDetailForUpdate(ctx, GetPurchaseDetailForUpdateParams(arg))
+		if err != nil {
+			return err
+		}
+
+		if err := lockParts(ctx, q, []int32{line.PartID}); err != nil {
+			return err
+		}
+
+		if _, err := q.DeletePurchaseDetail(ctx, arg); err != nil {
+			return err
+		}
+
+		return checkStockNotNegative(ctx, q, line.PartID)
+	})
+}
+
+// DeletePurchaseInvoiceTx deletes a purchase invoice and its lines. A ConflictError is returned
+// when the parts of one of the lines were already used, so deleting it would take the stock on
+// hand negative, and sql.ErrNoRows when the invoice does not exist.
+func (store *SQLStore) DeletePurchaseInvoiceTx(ctx context.Context, id int32) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		invoice, err := q.GetPurchaseInvoiceForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		lines, err := q.ListPurchaseDetails(ctx, invoice.ID)
+		if err != nil {
+			return err
+		}
+
+		partIDs := make([]int32, 0, len(lines))
+		for _, line := range lines {
+			partIDs = append(partIDs, line.PartID)
+		}
+		if err := lockParts(ctx, q, partIDs); err != nil {
+			return err
+		}
+
+		if _, err := q.DeletePurchaseInvoice(ctx, invoice.ID); err != nil {
+			return err
+		}
+
+		for _, partID := range partIDs {
+			if err := checkStockNotNegative(ctx, q, partID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// lockParts locks the rows of the parts, each once and in the order of their ids so two
+// transactions locking the same parts cannot deadlock
+func lockParts(ctx context.Context, q *Queries, partIDs []int32) error {
+	ids := append([]int32(nil), partIDs...)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	for i, id := range ids {
+		if i > 0 && id == ids[i-1] {
+			continue
+		}
+		if _, err := q.GetPartForUpdate(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkStockNotNegative returns a ConflictError when more of a part was used on service orders
+// than is left purchased
+func checkStockNotNegative(ctx context.Context, q *Queries, partID int32) error {
+	stock, err := q.GetPartStock(ctx, partID)
+	if err != nil {
+		return err
+	}
+
+	if stock.OnHand < 0 {
+		return conflictf("%s was already used, the change would leave %d on hand", stock.Name, stock.OnHand)
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomSaleInvoice(t *testing.T, order ServiceOrder) SaleInvoice {
+	ref, err := testQueries.NextSaleInvoiceRef(context.Background())
+	require.NoError(t, err)
+	require.Regexp(t, `^INV-\d{6,}$`, ref)
+
+	invoice, err := testQueries.CreateSaleInvoice(context.Background(), CreateSaleInvoiceParams{
+		ServiceOrderID: order.ID,
+		Ref:            ref,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, invoice)
+
+	require.Equal(t, order.ID, invoice.ServiceOrderID)
+	require.True(t, invoice.Date.Valid)
+	require.Equal(t, ref, invoice.Ref)
+	require.Equal(t, "0", invoice.Total)
+
+	require.NotZero(t, invoice.ID)
+
+	return invoice
+}
+
+func TestCreateSaleInvoice(t *testing.T) {
+	car := createRandomCar(t, createRandomCustomer(t))
+	invoice1 := createRandomSaleInvoice(t, createRandomServiceOrder(t, car))
+	invoice2 := createRandomSaleInvoice(t, createRandomServiceOrder(t, car))
+	require.NotEqual(t, invoice1.Ref, invoice2.Ref)
+
+	ref, err := testQueries.NextSaleInvoiceRef(context.Background())
+	require.NoError(t, err)
+
+	// an order is invoiced only once
+	_, err = testQueries.CreateSaleInvoice(context.Background(), CreateSaleInvoiceParams{
+		ServiceOrderID: invoice1.ServiceOrderID,
+		Ref:            ref,
+	})
+	require.Error(t, err)
+}
+
+func TestGetSaleInvoice(t *testing.T) {
+	order := createRandomServiceOrder(t, createRandomCar(t, createRandomCustomer(t)))
+	invoice1 := createRandomSaleInvoice(t, order)
+
+	invoice2, err := testQueries.GetSaleInvoice(context.Background(), invoice1.ID)
+	require.NoError(t, err)
+	require.Equal(t, invoice1, invoice2)
+
+	invoice3, err := testQueries.GetSaleInvoiceByServiceOrder(context.Background(), order.ID)
+	require.NoError(t, err)
+	require.Equal(t, invoice1, invoice3)
+}
+
+func TestListSaleInvoices(t *testing.T) {
+	car := createRandomCar(t, createRandomCustomer(t))
+	for i := 0; i < 10; i++ {
+		createRandomSaleInvoice(t, createRandomServiceOrder(t, car))
+	}
+
+	arg := ListSaleInvoicesParams{
+		Limit:   5,
+		AfterID: 0,
+	}
+
+	invoices, err := testQueries.ListSaleInvoices(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, invoices, 5)
+}
+
+func TestSaleInvoiceLines(t *testing.T) {
+	order := createRandomServiceOrder(t, createRandomCar(t, createRandomCustomer(t)))
+
+	part1 := createRandomPart(t)
+	_, err := testQueries.CreatePartDetail(context.Background(), CreatePartDetailParams{
+		PartID:         part1.ID,
+		ServiceOrderID: order.ID,
+		Quantity:       2,
+		Price:          sql.NullString{String: "10.50", Valid: true},
+	})
+	require.NoError(t, err)
+
+	part2 := createRandomPart(t)
+	_, err = testQueries.CreatePartDetail(context.Background(), CreatePartDetailParams{
+		PartID:         part2.ID,
+		ServiceOrderID: order.ID,
+		Quantity:       3,
+		Price:          sql.NullString{String: "4.00", Valid: true},
+	})
+	require.NoError(t, err)
+
+	invoice := createRandomSaleInvoice(t, order)
+
+	err = testQueries.CreateSaleInvoiceServiceLines(context.Background(), CreateSaleInvoiceServiceLinesParams{
+		SaleInvoiceID:  invoice.ID,
+		ServiceOrderID: order.ID,
+	})
+	require.NoError(t, err)
+	err = testQueries.CreateSaleInvoicePartLines(context.Background(), CreateSaleInvoicePartLinesParams{
+		SaleInvoiceID:  invoice.ID,
+		ServiceOrderID: order.ID,
+	})
+	require.NoError(t, err)
+
+	invoice, err = testQueries.UpdateSaleInvoiceTotal(context.Background(), invoice.ID)
+	require.NoError(t, err)
+	require.Equal(t, "33.00", invoice.Total)
+
+	lines, err := testQueries.ListSaleInvoiceLines(context.Background(), invoice.ID)
+	require.NoError(t, err)
+	require.Len(t, lines, 2)
+
+	require.Equal(t, "part", lines[0].Kind)
+	require.Equal(t, part1.Name, lines[0].Description)
+	require.Equal(t, int32(2), lines[0].Quantity)
+	require.Equal(t, "10.50", lines[0].UnitPrice)
+	require.Equal(t, "21.00", lines[0].Amount)
+	require.Equal(t, part2.Name, lines[1].Description)
+	require.Equal(t, "12.00", lines[1].Amount)
+
+	// later price changes do not rewrite the invoice
+	_, err = testQueries.UpdatePart(context.Background(), UpdatePartParams{
+		ID:          part1.ID,
+		Name:        part1.Name,
+		Description: part1.Description,
+		RetailPrice: "99.00",
+	})
+	require.NoError(t, err)
+
+	invoice2, err := testQueries.GetSaleInvoice(context.Background(), invoice.ID)
+	require.NoError(t, err)
+	require.Equal(t, invoice.Total, invoice2.Total)
+
+	lines2, err := testQueries.ListSaleInvoiceLines(context.Background(), invoice.ID)
+	require.NoError(t, err)
+	require.Equal(t, lines, lines2)
+}
@@ -0,0 +1,81 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+// CreateSaleInvoiceTxResult is the result of the sale invoice creation transaction
+type CreateSaleInvoiceTxResult struct {
+	Invoice SaleInvoice       `json:"invoice"`
+	Lines   []SaleInvoiceLine `json:"lines"`
+}
+
+// CreateSaleInvoiceTx invoices a ready or returned service order: the invoice, its lines copied
+// from the order and its total are created together. The order row is locked so its state cannot
+// change while it is invoiced. A ConflictError is returned when the order cannot be invoiced, and
+// sql.ErrNoRows when it does not exist.
+func (store *SQLStore) CreateSaleInvoiceTx(ctx context.Context, serviceOrderID int32) (CreateSaleInvoiceTxResult, error) {
+	var result CreateSaleInvoiceTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		order, err := q.GetServiceOrderForUpdate(ctx, serviceOrderID)
+		if err != nil {
+			return err
+		}
+
+		if order.State != ServiceOrderReady && order.State != ServiceOrderReturned {
+			return conflictf("service order %d is %s, only ready or returned orders can be invoiced", order.ID, ServiceOrderStateName(order.State))
+		}
+
+		existing, err := q.GetSaleInvoiceByServiceOrder(ctx, order.ID)
+		if err == nil {
+			return conflictf("service order %d is already invoiced as %s", order.ID, existing.Ref)
+		}
+		if err != sql.ErrNoRows {
+			return err
+		}
+
+		// the ref is taken last, the counter row is then locked for the shortest time
+		ref, err := q.NextSaleInvoiceRef(ctx)
+		if err != nil {
+			return err
+		}
+
+		invoice, err := q.CreateSaleInvoice(ctx, CreateSaleInvoiceParams{
+			ServiceOrderID: order.ID,
+			Ref:            ref,
+		})
+		if err != nil {
+			return err
+		}
+
+		// the services declined by the customer are not charged
+		err = q.CreateSaleInvoiceServiceLines(ctx, CreateSaleInvoiceServiceLinesParams{
+			SaleInvoiceID:  invoice.ID,
+			ServiceOrderID: order.ID,
+			DeclinedState:  ServiceDetailDeclined,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = q.CreateSaleInvoicePartLines(ctx, CreateSaleInvoicePartLinesParams{
+			SaleInvoiceID:  invoice.ID,
+			ServiceOrderID: order.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Invoice, err = q.UpdateSaleInvoiceTotal(ctx, invoice.ID)
+		if err != nil {
+			return err
+		}
+
+		result.Lines, err = q.ListSaleInvoiceLines(ctx, invoice.ID)
+		return err
+	})
+
+	return result, err
+}
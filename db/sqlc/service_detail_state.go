@@ -0,0 +1,54 @@
+package db
+
+// States of a service line, as stored in SERVICE_DETAILS.STATE.
+const (
+	ServiceDetailPending int32 = iota + 1
+	ServiceDetailStarted
+	ServiceDetailPaused
+	ServiceDetailDone
+	ServiceDetailDeclined
+)
+
+var serviceDetailStateNames = map[int32]string{
+	ServiceDetailPending:  "pending",
+	ServiceDetailStarted:  "started",
+	ServiceDetailPaused:   "paused",
+	ServiceDetailDone:     "done",
+	ServiceDetailDeclined: "declined",
+}
+
+// serviceDetailTransitions lists, for every state, the states a service line may move to.
+// Done and declined lines are final, the customer can only decline the work not done yet.
+var serviceDetailTransitions = map[int32][]int32{
+	ServiceDetailPending: {ServiceDetailStarted, ServiceDetailDeclined},
+	ServiceDetailStarted: {ServiceDetailPaused, ServiceDetailDone},
+	ServiceDetailPaused:  {ServiceDetailStarted, ServiceDetailDone, ServiceDetailDeclined},
+}
+
+// ServiceDetailStateName returns the name of a service line state
+func ServiceDetailStateName(state int32) string {
+	return serviceDetailStateNames[state]
+}
+
+// ParseServiceDetailState returns the service line state with the given name
+func ParseServiceDetailState(name string) (int32, bool) {
+	for state, stateName := range serviceDetailStateNames {
+		if stateName == name {
+			return state, true
+		}
+	}
+	return 0, false
+}
+
+// CanTransitionServiceDetail reports whether a service line may move from one state to another
+func CanTransitionServiceDetail(from, to int32) bool {
+	for _, next := range serviceDetailTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// FinishedServiceDetailStates lists the final states of a service line
+var FinishedServiceDetailStates = []int32{ServiceDetailDone, ServiceDetailDeclined}
@@ -0,0 +1,45 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseServiceDetailState(t *testing.T) {
+	for state := ServiceDetailPending; state <= ServiceDetailDeclined; state++ {
+		name := ServiceDetailStateName(state)
+		require.NotEmpty(t, name)
+
+		parsed, ok := ParseServiceDetailState(name)
+		require.True(t, ok)
+		require.Equal(t, state, parsed)
+	}
+
+	_, ok := ParseServiceDetailState("stopped")
+	require.False(t, ok)
+}
+
+func TestCanTransitionServiceDetail(t *testing.T) {
+	testCases := []struct {
+		from int32
+		to   int32
+		ok   bool
+	}{
+		{ServiceDetailPending, ServiceDetailStarted, true},
+		{ServiceDetailPending, ServiceDetailDeclined, true},
+		{ServiceDetailPending, ServiceDetailDone, false},
+		{ServiceDetailStarted, ServiceDetailPaused, true},
+		{ServiceDetailStarted, ServiceDetailDone, true},
+		{ServiceDetailStarted, ServiceDetailDeclined, false},
+		{ServiceDetailPaused, ServiceDetailStarted, true},
+		{ServiceDetailPaused, ServiceDetailDeclined, true},
+		{ServiceDetailDone, ServiceDetailStarted, false},
+		{ServiceDetailDeclined, ServiceDetailPending, false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.ok, CanTransitionServiceDetail(tc.from, tc.to),
+			"%s -> %s", ServiceDetailStateName(tc.from), ServiceDetailStateName(tc.to))
+	}
+}
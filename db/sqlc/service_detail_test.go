@@ -0,0 +1,262 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddServiceDetailTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	service := createRandomService(t)
+	order := createRandomServiceOrder(t, createRandomCar(t, createRandomCustomer(t)))
+
+	// the price defaults to the min price of the catalog
+	detail, err := store.AddServiceDetailTx(context.Background(), AddServiceDetailTxParams{
+		ServiceOrderID: order.ID,
+		ServiceID:      service.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, service.MinPrice, detail.Price)
+	require.False(t, detail.PriceOverriddenBy.Valid)
+
+	details, err := store.ListServiceOrderServices(context.Background(), order.ID)
+	require.NoError(t, err)
+	require.Equal(t, []ServiceDetail{detail}, details)
+
+	rows, err := store.DeleteServiceDetail(context.Background(), DeleteServiceDetailParams{
+		ServiceOrderID: order.ID,
+		ServiceID:      service.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rows)
+}
+
+func TestAddServiceDetailTxPriceRange(t *testing.T) {
+	store := NewStore(testDB)
+
+	service := createRandomService(t)
+	order := createRandomServiceOrder(t, createRandomCar(t, createRandomCustomer(t)))
+	admin := createRandomUser(t, util.RoleAdmin)
+
+	arg := AddServiceDetailTxParams{
+		ServiceOrderID: order.ID,
+		ServiceID:      service.ID,
+		Price:          "2000.01",
+	}
+	_, err := store.AddServiceDetailTx(context.Background(), arg)
+	var conflict *ConflictError
+	require.True(t, errors.As(err, &conflict))
+
+	arg.OverriddenBy = admin.Username
+	arg.OverrideReason = "engine bay full of mud"
+	detail, err := store.AddServiceDetailTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, sql.NullString{String: arg.Price, Valid: true}, detail.Price)
+	require.Equal(t, sql.NullString{String: admin.Username, Valid: true}, detail.PriceOverriddenBy)
+	require.Equal(t, sql.NullString{String: arg.OverrideReason, Valid: true}, detail.PriceOverrideReason)
+}
+
+func TestPriceInRange(t *testing.T) {
+	min := sql.NullString{String: "1000.00", Valid: true}
+	max := sql.NullString{String: "2000", Valid: true}
+
+	testCases := []struct {
+		name     string
+		price    string
+		min, max sql.NullString
+		inRange  bool
+	}{
+		{"Min", "1000", min, max, true},
+		{"Max", "2000.00", min, max, true},
+		{"Below", "999.99", min, max, false},
+		{"Above", "2000.01", min, max, false},
+		{"NoBounds", "1", sql.NullString{}, sql.NullString{}, true},
+		{"NoMax", "5000", min, sql.NullString{}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			inRange, err := priceInRange(tc.price, tc.min, tc.max)
+			require.NoError(t, err)
+			require.Equal(t, tc.inRange, inRange)
+		})
+	}
+
+	_, err := priceInRange("abc", min, max)
+	require.Error(t, err)
+}
+
+func TestTransitionServiceDetailTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	order := createRandomServiceOrder(t, createRandomCar(t, createRandomCustomer(t)))
+	for _, state := range []int32{ServiceOrderDiagnosis, ServiceOrderInProgress} {
+		var err error
+		order, err = store.UpdateServiceOrderState(context.Background(), UpdateServiceOrderStateParams{
+			ID:        order.ID,
+			FromState: order.State,
+			State:     state,
+		})
+		require.NoError(t, err)
+	}
+
+	var services []Service
+	for i := 0; i < 2; i++ {
+		service := createRandomService(t)
+		_, err := store.AddServiceDetailTx(context.Background(), AddServiceDetailTxParams{
+			ServiceOrderID: order.ID,
+			ServiceID:      service.ID,
+		})
+		require.NoError(t, err)
+		services = append(services, service)
+	}
+
+	transition := func(service Service, state int32) (TransitionServiceDetailTxResult, error) {
+		return store.TransitionServiceDetailTx(context.Background(), TransitionServiceDetailTxParams{
+			ServiceOrderID: order.ID,
+			ServiceID:      service.ID,
+			State:          state,
+		})
+	}
+
+	result, err := transition(services[0], ServiceDetailDone)
+	var conflict *ConflictError
+	require.True(t, errors.As(err, &conflict))
+
+	result, err = transition(services[0], ServiceDetailStarted)
+	require.NoError(t, err)
+	require.Equal(t, ServiceDetailStarted, result.ServiceDetail.State)
+	require.True(t, result.ServiceDetail.StartedAt.Valid)
+	require.False(t, result.ServiceDetail.StoppedAt.Valid)
+	startedAt := result.ServiceDetail.StartedAt
+
+	result, err = transition(services[0], ServiceDetailPaused)
+	require.NoError(t, err)
+	require.True(t, result.ServiceDetail.StoppedAt.Valid)
+
+	// resuming keeps the time the work first started
+	result, err = transition(services[0], ServiceDetailStarted)
+	require.NoError(t, err)
+	require.Equal(t, startedAt, result.ServiceDetail.StartedAt)
+	require.False(t, result.ServiceDetail.StoppedAt.Valid)
+
+	result, err = transition(services[0], ServiceDetailDone)
+	require.NoError(t, err)
+	require.True(t, result.ServiceDetail.StoppedAt.Valid)
+	require.Equal(t, ServiceOrderInProgress, result.ServiceOrder.State)
+
+	// the order is ready once its last line is finished
+	result, err = transition(services[1], ServiceDetailDeclined)
+	require.NoError(t, err)
+	require.Equal(t, ServiceOrderReady, result.ServiceOrder.State)
+	require.Greater(t, result.ServiceOrder.Version, order.Version)
+
+	// the declined service is not invoiced
+	invoice, err := store.CreateSaleInvoiceTx(context.Background(), order.ID)
+	require.NoError(t, err)
+	require.Len(t, invoice.Lines, 1)
+	require.Equal(t, services[0].Name, invoice.Lines[0].Description)
+
+	_, err = transition(services[1], ServiceDetailStarted)
+	require.True(t, errors.As(err, &conflict))
+}
+
+func TestTransitionServiceDetailTxInvoiced(t *testing.T) {
+	store := NewStore(testDB)
+
+	order := createRandomServiceOrder(t, createRandomCar(t, createRandomCustomer(t)))
+	service := createRandomService(t)
+	_, err := store.AddServiceDetailTx(context.Background(), AddServiceDetailTxParams{
+		ServiceOrderID: order.ID,
+		ServiceID:      service.ID,
+	})
+	require.NoError(t, err)
+
+	// an order can be moved to ready with lines still pending, once invoiced they stay so
+	order, err = store.UpdateServiceOrderState(context.Background(), UpdateServiceOrderStateParams{
+		ID:        order.ID,
+		FromState: order.State,
+		State:     ServiceOrderReady,
+	})
+	require.NoError(t, err)
+	createRandomSaleInvoice(t, order)
+
+	_, err = store.TransitionServiceDetailTx(context.Background(), TransitionServiceDetailTxParams{
+		ServiceOrderID: order.ID,
+		ServiceID:      service.ID,
+		State:          ServiceDetailDeclined,
+	})
+	var conflict *ConflictError
+	require.True(t, errors.As(err, &conflict))
+}
+
+func TestDeleteServiceDetailTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	order := createRandomServiceOrder(t, createRandomCar(t, createRandomCustomer(t)))
+	for _, state := range []int32{ServiceOrderDiagnosis, ServiceOrderInProgress} {
+		var err error
+		order, err = store.UpdateServiceOrderState(context.Background(), UpdateServiceOrderStateParams{
+			ID:        order.ID,
+			FromState: order.State,
+			State:     state,
+		})
+		require.NoError(t, err)
+	}
+
+	var services []Service
+	for i := 0; i < 2; i++ {
+		service := createRandomService(t)
+		_, err := store.AddServiceDetailTx(context.Background(), AddServiceDetailTxParams{
+			ServiceOrderID: order.ID,
+			ServiceID:      service.ID,
+		})
+		require.NoError(t, err)
+		services = append(services, service)
+	}
+
+	// a line mechanics worked on is kept for their hours
+	_, err := store.ClockInTx(context.Background(), ClockInTxParams{
+		MechanicID:     createRandomMechanic(t).ID,
+		ServiceOrderID: order.ID,
+		ServiceID:      services[0].ID,
+	})
+	require.NoError(t, err)
+
+	err = store.DeleteServiceDetailTx(context.Background(), DeleteServiceDetailParams{
+		ServiceOrderID: order.ID,
+		ServiceID:      services[0].ID,
+	})
+	var conflict *ConflictError
+	require.True(t, errors.As(err, &conflict))
+
+	_, err = store.TransitionServiceDetailTx(context.Background(), TransitionServiceDetailTxParams{
+		ServiceOrderID: order.ID,
+		ServiceID:      services[0].ID,
+		State:          ServiceDetailDone,
+	})
+	require.NoError(t, err)
+
+	// removing the last unfinished line readies the order
+	err = store.DeleteServiceDetailTx(context.Background(), DeleteServiceDetailParams{
+		ServiceOrderID: order.ID,
+		ServiceID:      services[1].ID,
+	})
+	require.NoError(t, err)
+
+	order, err = store.GetServiceOrder(context.Background(), order.ID)
+	require.NoError(t, err)
+	require.Equal(t, ServiceOrderReady, order.State)
+
+	err = store.DeleteServiceDetailTx(context.Background(), DeleteServiceDetailParams{
+		ServiceOrderID: order.ID,
+		ServiceID:      services[1].ID,
+	})
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+}
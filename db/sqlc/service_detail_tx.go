@@ -0,0 +1,267 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// AddServiceDetailTxParams contains the input parameters of the service line transaction
+type AddServiceDetailTxParams struct {
+	ServiceOrderID int32 `json:"service_order_id"`
+	ServiceID      int32 `json:"service_id"`
+	// Price is the price charged, the min price of the service when empty
+	Price string `json:"price"`
+	// OverriddenBy is the admin allowing a price outside the range of the catalog for the
+	// OverrideReason, the range is enforced when empty
+	OverriddenBy   string `json:"overridden_by"`
+	OverrideReason string `json:"override_reason"`
+}
+
+// AddServiceDetailTx adds a service of the catalog to an open service order. A ConflictError
+// is returned when the order is closed or invoiced or the price is outside the range of the
+// catalog without an override, and sql.ErrNoRows when the order or the service does not exist.
+func (store *SQLStore) AddServiceDetailTx(ctx context.Context, arg AddServiceDetailTxParams) (ServiceDetail, error) {
+	var detail ServiceDetail
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		order, err := q.GetServiceOrderForUpdate(ctx, arg.ServiceOrderID)
+		if err != nil {
+			return err
+		}
+
+		if IsServiceOrderClosed(order.State) {
+			return conflictf("service order %d is %s", order.ID, ServiceOrderStateName(order.State))
+		}
+
+		if err := checkNotInvoiced(ctx, q, order.ID); err != nil {
+			return err
+		}
+
+		service, err := q.GetService(ctx, arg.ServiceID)
+		if err != nil {
+			return err
+		}
+
+		price := arg.Price
+		if price == "" {
+			if !service.MinPrice.Valid {
+				return conflictf("service %s has no price in the catalog, the price must be given", service.Name)
+			}
+			price = service.MinPrice.String
+		}
+
+		if arg.OverriddenBy == "" {
+			inRange, err := priceInRange(price, service.MinPrice, service.MaxPrice)
+			if err != nil {
+				return err
+			}
+			if !inRange {
+				return conflictf("price %s of service %s is outside its range %s-%s, only an admin can override it",
+					price, service.Name, service.MinPrice.String, service.MaxPrice.String)
+			}
+		}
+
+		detail, err = q.CreateServiceDetail(ctx, CreateServiceDetailParams{
+			ServiceID:           service.ID,
+			ServiceOrderID:      order.ID,
+			Price:               sql.NullString{String: price, Valid: true},
+			PriceOverrideReason: sql.NullString{String: arg.OverrideReason, Valid: arg.OverriddenBy != ""},
+			PriceOverriddenBy:   sql.NullString{String: arg.OverriddenBy, Valid: arg.OverriddenBy != ""},
+		})
+		return err
+	})
+
+	return detail, err
+}
+
+// TransitionServiceDetailTxParams contains the input parameters of the service line transition
+type TransitionServiceDetailTxParams struct {
+	ServiceOrderID int32 `json:"service_order_id"`
+	ServiceID      int32 `json:"service_id"`
+	State          int32 `json:"state"`
+}
+
+// TransitionServiceDetailTxResult is the result of the service line transition, with the
+// service order as it is after the transition
+type TransitionServiceDetailTxResult struct {
+	ServiceDetail ServiceDetail `json:"service_detail"`
+	ServiceOrder  ServiceOrder  `json:"service_order"`
+}
+
+// TransitionServiceDetailTx moves a service line of an open service order to another state,
+// recording when the work on it started and stopped. When the last line of an order in
+// progress is done or declined, the order moves to ready, and the mechanics clocked in on a
+// line that stops are clocked out. A ConflictError is returned when the order is closed or
+// invoiced or the line cannot move to the state, and sql.ErrNoRows when the order has no line of
+// the service.
+func (store *SQLStore) TransitionServiceDetailTx(ctx context.Context, arg TransitionServiceDetailTxParams) (TransitionServiceDetailTxResult, error) {
+	var result TransitionServiceDetailTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		order, err := q.GetServiceOrderForUpdate(ctx, arg.ServiceOrderID)
+		if err != nil {
+			return err
+		}
+
+		if IsServiceOrderClosed(order.State) {
+			return conflictf("service order %d is %s", order.ID, ServiceOrderStateName(order.State))
+		}
+
+		if err := checkNotInvoiced(ctx, q, order.ID); err != nil {
+			return err
+		}
+
+		detail, err := q.GetServiceDetailForUpdate(ctx, GetServiceDetailForUpdateParams{
+			ServiceOrderID: order.ID,
+			ServiceID:      arg.ServiceID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if !CanTransitionServiceDetail(detail.State, arg.State) {
+			return conflictf("service line cannot move from %s to %s",
+				ServiceDetailStateName(detail.State), ServiceDetailStateName(arg.State))
+		}
+
+		result.ServiceDetail, err = q.UpdateServiceDetailState(ctx, serviceDetailStateParams(detail, arg.State, time.Now()))
+		if err != nil {
+			return err
+		}
+
+		// the mechanics still clocked in on a line stop working on it with the line
+		if detail.State == ServiceDetailStarted {
+			err = q.ClockOutServiceDetail(ctx, detail.ID)
+			if err != nil {
+				return err
+			}
+		}
+
+		result.ServiceOrder, err = readyWhenFinished(ctx, q, order)
+		return err
+	})
+
+	return result, err
+}
+
+// DeleteServiceDetailTx removes a service line from an open service order that was not invoiced.
+// A line mechanics worked on is kept for their hours, it can be declined instead. When the last
+// unfinished line of an order in progress is removed, the order moves to ready. A ConflictError
+// is returned when the order is closed or invoiced or the line has labor, and sql.ErrNoRows when
+// the order has no line of the service.
+func (store *SQLStore) DeleteServiceDetailTx(ctx context.Context, arg DeleteServiceDetailParams) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		order, err := q.GetServiceOrderForUpdate(ctx, arg.ServiceOrderID)
+		if err != nil {
+			return err
+		}
+
+		if IsServiceOrderClosed(order.State) {
+			return conflictf("service order %d is %s", order.ID, ServiceOrderStateName(order.State))
+		}
+
+		if err := checkNotInvoiced(ctx, q, order.ID); err != nil {
+			return err
+		}
+
+		detail, err := q.GetServiceDetailForUpdate(ctx, GetServiceDetailForUpdateParams(arg))
+		if err != nil {
+			return err
+		}
+
+		entries, err := q.CountServiceDetailLaborEntries(ctx, detail.ID)
+		if err != nil {
+			return err
+		}
+		if entries > 0 {
+			return conflictf("mechanics worked on the service line, decline it instead")
+		}
+
+		if _, err := q.DeleteServiceDetail(ctx, arg); err != nil {
+			return err
+		}
+
+		_, err = readyWhenFinished(ctx, q, order)
+		return err
+	})
+}
+
+// readyWhenFinished moves a service order in progress to ready once it has lines and all of them
+// are done or declined, and returns the order as it is after the check
+func readyWhenFinished(ctx context.Context, q *Queries, order ServiceOrder) (ServiceOrder, error) {
+	if !CanTransitionServiceOrder(order.State, ServiceOrderReady) {
+		return order, nil
+	}
+
+	lines, err := q.ListServiceOrderServices(ctx, order.ID)
+	if err != nil || len(lines) == 0 {
+		return order, err
+	}
+
+	unfinished, err := q.CountUnfinishedServiceDetails(ctx, CountUnfinishedServiceDetailsParams{
+		ServiceOrderID: order.ID,
+		FinishedStates: FinishedServiceDetailStates,
+	})
+	if err != nil || unfinished > 0 {
+		return order, err
+	}
+
+	return q.UpdateServiceOrderState(ctx, UpdateServiceOrderStateParams{
+		ID:           order.ID,
+		FromState:    order.State,
+		State:        ServiceOrderReady,
+		DateReturned: order.DateReturned,
+	})
+}
+
+// serviceDetailStateParams moves a service line to a state, recording when the work on it first
+// started and when it last stopped
+func serviceDetailStateParams(detail ServiceDetail, state int32, now time.Time) UpdateServiceDetailStateParams {
+	arg := UpdateServiceDetailStateParams{
+		ID:        detail.ID,
+		FromState: detail.State,
+		State:     state,
+		StartedAt: detail.StartedAt,
+		StoppedAt: detail.StoppedAt,
+	}
+	if state == ServiceDetailStarted {
+		if !arg.StartedAt.Valid {
+			arg.StartedAt = sql.NullTime{Time: now, Valid: true}
+		}
+		arg.StoppedAt = sql.NullTime{}
+	} else if detail.State == ServiceDetailStarted {
+		arg.StoppedAt = sql.NullTime{Time: now, Valid: true}
+	}
+	return arg
+}
+
+// priceInRange reports whether a decimal price is within the bounds of a service, a NULL bound
+// does not limit the price
+func priceInRange(price string, min, max sql.NullString) (bool, error) {
+	p, ok := new(big.Rat).SetString(price)
+	if !ok {
+		return false, fmt.Errorf("invalid price %q", price)
+	}
+	if min.Valid {
+		bound, ok := new(big.Rat).SetString(min.String)
+		if !ok {
+			return false, fmt.Errorf("invalid min price %q", min.String)
+		}
+		if p.Cmp(bound) < 0 {
+			return false, nil
+		}
+	}
+	if max.Valid {
+		bound, ok := new(big.Rat).SetString(max.String)
+		if !ok {
+			return false, fmt.Errorf("invalid max price %q", max.String)
+		}
+		if p.Cmp(bound) > 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
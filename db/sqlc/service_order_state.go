@@ -0,0 +1,65 @@
+package db
+
+// States of a service order, as stored in SERVICE_ORDERS.STATE.
+const (
+	ServiceOrderOpen int32 = iota + 1
+	ServiceOrderDiagnosis
+	ServiceOrderAwaitingParts
+	ServiceOrderInProgress
+	ServiceOrderReady
+	ServiceOrderReturned
+	ServiceOrderCancelled
+)
+
+var serviceOrderStateNames = map[int32]string{
+	ServiceOrderOpen:          "open",
+	ServiceOrderDiagnosis:     "diagnosis",
+	ServiceOrderAwaitingParts: "awaiting_parts",
+	ServiceOrderInProgress:    "in_progress",
+	ServiceOrderReady:         "ready",
+	ServiceOrderReturned:      "returned",
+	ServiceOrderCancelled:     "cancelled",
+}
+
+// serviceOrderTransitions lists, for every state, the states a service order may move to.
+// Returned and cancelled orders are final.
+var serviceOrderTransitions = map[int32][]int32{
+	ServiceOrderOpen:          {ServiceOrderDiagnosis, ServiceOrderCancelled},
+	ServiceOrderDiagnosis:     {ServiceOrderAwaitingParts, ServiceOrderInProgress, ServiceOrderCancelled},
+	ServiceOrderAwaitingParts: {ServiceOrderInProgress, ServiceOrderCancelled},
+	ServiceOrderInProgress:    {ServiceOrderAwaitingParts, ServiceOrderReady, ServiceOrderCancelled},
+	ServiceOrderReady:         {ServiceOrderInProgress, ServiceOrderReturned, ServiceOrderCancelled},
+}
+
+// ServiceOrderStateName returns the name of a service order state
+func ServiceOrderStateName(state int32) string {
+	return serviceOrderStateNames[state]
+}
+
+// ParseServiceOrderState returns the service order state with the given name
+func ParseServiceOrderState(name string) (int32, bool) {
+	for state, stateName := range serviceOrderStateNames {
+		if stateName == name {
+			return state, true
+		}
+	}
+	return 0, false
+}
+
+// CanTransitionServiceOrder reports whether a service order may move from one state to another
+func CanTransitionServiceOrder(from, to int32) bool {
+	for _, next := range serviceOrderTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// ClosedServiceOrderStates lists the final states of a service order
+var ClosedServiceOrderStates = []int32{ServiceOrderReturned, ServiceOrderCancelled}
+
+// IsServiceOrderClosed reports whether a service order reached a final state
+func IsServiceOrderClosed(state int32) bool {
+	return len(serviceOrderTransitions[state]) == 0
+}
@@ -0,0 +1,57 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseServiceOrderState(t *testing.T) {
+	for state := ServiceOrderOpen; state <= ServiceOrderCancelled; state++ {
+		name := ServiceOrderStateName(state)
+		require.NotEmpty(t, name)
+
+		parsed, ok := ParseServiceOrderState(name)
+		require.True(t, ok)
+		require.Equal(t, state, parsed)
+	}
+
+	_, ok := ParseServiceOrderState("finished")
+	require.False(t, ok)
+}
+
+func TestCanTransitionServiceOrder(t *testing.T) {
+	testCases := []struct {
+		from int32
+		to   int32
+		ok   bool
+	}{
+		{ServiceOrderOpen, ServiceOrderDiagnosis, true},
+		{ServiceOrderOpen, ServiceOrderReady, false},
+		{ServiceOrderDiagnosis, ServiceOrderAwaitingParts, true},
+		{ServiceOrderAwaitingParts, ServiceOrderInProgress, true},
+		{ServiceOrderInProgress, ServiceOrderAwaitingParts, true},
+		{ServiceOrderInProgress, ServiceOrderReady, true},
+		{ServiceOrderReady, ServiceOrderReturned, true},
+		{ServiceOrderReady, ServiceOrderCancelled, true},
+		{ServiceOrderReturned, ServiceOrderCancelled, false},
+		{ServiceOrderOpen, ServiceOrderOpen, false},
+		{ServiceOrderReturned, ServiceOrderOpen, false},
+		{ServiceOrderCancelled, ServiceOrderOpen, false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.ok, CanTransitionServiceOrder(tc.from, tc.to),
+			"%s -> %s", ServiceOrderStateName(tc.from), ServiceOrderStateName(tc.to))
+	}
+}
+
+func TestIsServiceOrderClosed(t *testing.T) {
+	for state := ServiceOrderOpen; state <= ServiceOrderCancelled; state++ {
+		closed := false
+		for _, s := range ClosedServiceOrderStates {
+			closed = closed || s == state
+		}
+		require.Equal(t, closed, IsServiceOrderClosed(state), ServiceOrderStateName(state))
+	}
+}
@@ -0,0 +1,182 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomServiceOrder(t *testing.T, car Car) ServiceOrder {
+
+	arg := CreateServiceOrderParams{
+		CarID:       car.ID,
+		Description: sql.NullString{String: util.RandomString(20), Valid: true},
+		State:       ServiceOrderOpen,
+		Mileage:     sql.NullInt32{Int32: int32(util.RandomInt(0, 300000)), Valid: true},
+	}
+
+	order, err := testQueries.CreateServiceOrder(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, order)
+
+	require.Equal(t, arg.CarID, order.CarID)
+	require.Equal(t, arg.Description, order.Description)
+	require.Equal(t, ServiceOrderOpen, order.State)
+	require.Equal(t, arg.Mileage, order.Mileage)
+	require.True(t, order.DateReceived.Valid)
+	require.False(t, order.DateReturned.Valid)
+
+	require.NotZero(t, order.ID)
+
+	return order
+}
+
+func TestCreateServiceOrder(t *testing.T) {
+	createRandomServiceOrder(t, createRandomCar(t, createRandomCustomer(t)))
+}
+
+func TestGetServiceOrder(t *testing.T) {
+	order1 := createRandomServiceOrder(t, createRandomCar(t, createRandomCustomer(t)))
+	order2, err := testQueries.GetServiceOrder(context.Background(), order1.ID)
+	require.NoError(t, err)
+	require.NotEmpty(t, order2)
+
+	require.Equal(t, order1, order2)
+}
+
+func TestUpdateServiceOrder(t *testing.T) {
+	order1 := createRandomServiceOrder(t, createRandomCar(t, createRandomCustomer(t)))
+
+	arg := UpdateServiceOrderParams{
+		ID:          order1.ID,
+		Description: sql.NullString{String: util.RandomString(20), Valid: true},
+		Mileage:     sql.NullInt32{Int32: 84500, Valid: true},
+	}
+
+	order2, err := testQueries.UpdateServiceOrder(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Description, order2.Description)
+	require.Equal(t, arg.Mileage, order2.Mileage)
+	require.Equal(t, order1.State, order2.State)
+}
+
+func TestPatchServiceOrder(t *testing.T) {
+	order1 := createRandomServiceOrder(t, createRandomCar(t, createRandomCustomer(t)))
+
+	order2, err := testQueries.PatchServiceOrder(context.Background(), PatchServiceOrderParams{
+		ID:      order1.ID,
+		Mileage: sql.NullInt32{Int32: 84500, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, order1.Description, order2.Description)
+	require.Equal(t, int32(84500), order2.Mileage.Int32)
+	require.Equal(t, order1.Version+1, order2.Version)
+
+	// a stale version is not patched
+	_, err = testQueries.PatchServiceOrder(context.Background(), PatchServiceOrderParams{
+		ID:       order1.ID,
+		Mileage:  sql.NullInt32{Int32: 90000, Valid: true},
+		Versions: []int32{order1.Version},
+	})
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+}
+
+func TestUpdateServiceOrderState(t *testing.T) {
+	order1 := createRandomServiceOrder(t, createRandomCar(t, createRandomCustomer(t)))
+
+	arg := UpdateServiceOrderStateParams{
+		ID:           order1.ID,
+		FromState:    ServiceOrderOpen,
+		State:        ServiceOrderDiagnosis,
+		DateReturned: order1.DateReturned,
+	}
+	order2, err := testQueries.UpdateServiceOrderState(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, ServiceOrderDiagnosis, order2.State)
+
+	// the order is no longer open
+	_, err = testQueries.UpdateServiceOrderState(context.Background(), arg)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+
+	arg = UpdateServiceOrderStateParams{
+		ID:           order1.ID,
+		FromState:    ServiceOrderDiagnosis,
+		State:        ServiceOrderCancelled,
+		DateReturned: sql.NullTime{Time: time.Now(), Valid: true},
+	}
+	order3, err := testQueries.UpdateServiceOrderState(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, ServiceOrderCancelled, order3.State)
+	require.True(t, order3.DateReturned.Valid)
+}
+
+func TestDeleteServiceOrder(t *testing.T) {
+	order1 := createRandomServiceOrder(t, createRandomCar(t, createRandomCustomer(t)))
+	rows, err := testQueries.DeleteServiceOrder(context.Background(), DeleteServiceOrderParams{ID: order1.ID})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rows)
+
+	order2, err := testQueries.GetServiceOrder(context.Background(), order1.ID)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+	require.Empty(t, order2)
+}
+
+func TestCarHistory(t *testing.T) {
+	car := createRandomCar(t, createRandomCustomer(t))
+	first := createRandomServiceOrder(t, car)
+	second := createRandomServiceOrder(t, car)
+	createRandomServiceOrder(t, createRandomCar(t, createRandomCustomer(t)))
+
+	service := createRandomService(t)
+	_, err := testQueries.CreateServiceDetail(context.Background(), CreateServiceDetailParams{
+		ServiceID:      service.ID,
+		ServiceOrderID: first.ID,
+		Price:          service.MinPrice,
+	})
+	require.NoError(t, err)
+
+	part := createRandomPart(t)
+	partDetail := createRandomPartDetail(t, part, second)
+
+	mechanic := createRandomMechanic(t)
+	_, err = testQueries.AssignMechanic(context.Background(), AssignMechanicParams{
+		MechanicID:     mechanic.ID,
+		ServiceOrderID: first.ID,
+	})
+	require.NoError(t, err)
+
+	invoice := createRandomSaleInvoice(t, first)
+
+	orders, err := testQueries.ListCarServiceOrders(context.Background(), car.ID)
+	require.NoError(t, err)
+	require.Len(t, orders, 2)
+	require.Equal(t, first.ID, orders[0].ID)
+	require.Equal(t, second.ID, orders[1].ID)
+
+	services, err := testQueries.ListCarServiceDetails(context.Background(), car.ID)
+	require.NoError(t, err)
+	require.Len(t, services, 1)
+	require.Equal(t, first.ID, services[0].ServiceOrderID)
+	require.Equal(t, service.Name, services[0].Name)
+
+	parts, err := testQueries.ListCarPartDetails(context.Background(), car.ID)
+	require.NoError(t, err)
+	require.Len(t, parts, 1)
+	require.Equal(t, second.ID, parts[0].ServiceOrderID)
+	require.Equal(t, part.Name, parts[0].Name)
+	require.Equal(t, partDetail.Quantity, parts[0].Quantity)
+
+	mechanics, err := testQueries.ListCarMechanics(context.Background(), car.ID)
+	require.NoError(t, err)
+	require.Len(t, mechanics, 1)
+	require.Equal(t, mechanic.FullName, mechanics[0].FullName)
+
+	invoices, err := testQueries.ListCarSaleInvoices(context.Background(), car.ID)
+	require.NoError(t, err)
+	require.Len(t, invoices, 1)
+	require.Equal(t, invoice.Ref, invoices[0].Ref)
+}
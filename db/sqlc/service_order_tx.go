@@ -0,0 +1,91 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// TransitionServiceOrderTxParams contains the input parameters of the service order transition
+type TransitionServiceOrderTxParams struct {
+	ID    int32 `json:"id"`
+	State int32 `json:"state"`
+}
+
+// TransitionServiceOrderTx moves a service order to another state, setting the return date when
+// it is returned. An invoiced order can only be returned, as reopening or cancelling it would
+// leave its invoice out of date. A ConflictError is returned when the order cannot move to the
+// state, and sql.ErrNoRows when it does not exist.
+func (store *SQLStore) TransitionServiceOrderTx(ctx context.Context, arg TransitionServiceOrderTxParams) (ServiceOrder, error) {
+	var result ServiceOrder
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		order, err := q.GetServiceOrderForUpdate(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		if !CanTransitionServiceOrder(order.State, arg.State) {
+			return conflictf("service order cannot move from %s to %s",
+				ServiceOrderStateName(order.State), ServiceOrderStateName(arg.State))
+		}
+
+		if arg.State != ServiceOrderReturned {
+			if err := checkNotInvoiced(ctx, q, order.ID); err != nil {
+				return err
+			}
+		}
+
+		params := UpdateServiceOrderStateParams{
+			ID:           order.ID,
+			FromState:    order.State,
+			State:        arg.State,
+			DateReturned: order.DateReturned,
+		}
+		if arg.State == ServiceOrderReturned {
+			params.DateReturned = sql.NullTime{Time: time.Now(), Valid: true}
+		}
+
+		result, err = q.UpdateServiceOrderState(ctx, params)
+		return err
+	})
+
+	return result, err
+}
+
+// DeleteServiceOrderTx deletes a service order that was not invoiced, as an issued invoice is
+// an accounting record that must be kept. A ConflictError is returned when the order is
+// invoiced, and sql.ErrNoRows when no order, or no order of one of the versions, exists.
+func (store *SQLStore) DeleteServiceOrderTx(ctx context.Context, arg DeleteServiceOrderParams) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		order, err := q.GetServiceOrderForUpdate(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := checkNotInvoiced(ctx, q, order.ID); err != nil {
+			return err
+		}
+
+		rows, err := q.DeleteServiceOrder(ctx, arg)
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return sql.ErrNoRows
+		}
+		return nil
+	})
+}
+
+// checkNotInvoiced returns a ConflictError when a service order has a sale invoice
+func checkNotInvoiced(ctx context.Context, q *Queries, serviceOrderID int32) error {
+	invoice, err := q.GetSaleInvoiceByServiceOrder(ctx, serviceOrderID)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return conflictf("service order %d is invoiced as %s", serviceOrderID, invoice.Ref)
+}
@@ -0,0 +1,107 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// readyServiceOrder creates a service order moved to ready
+func readyServiceOrder(t *testing.T) ServiceOrder {
+	order := createRandomServiceOrder(t, createRandomCar(t, createRandomCustomer(t)))
+	order, err := testQueries.UpdateServiceOrderState(context.Background(), UpdateServiceOrderStateParams{
+		ID:        order.ID,
+		State:     ServiceOrderReady,
+		FromState: order.State,
+	})
+	require.NoError(t, err)
+	return order
+}
+
+func TestTransitionServiceOrderTx(t *testing.T) {
+	store := NewStore(testDB)
+	order := createRandomServiceOrder(t, createRandomCar(t, createRandomCustomer(t)))
+
+	_, err := store.TransitionServiceOrderTx(context.Background(), TransitionServiceOrderTxParams{
+		ID:    order.ID,
+		State: ServiceOrderReturned,
+	})
+	var conflict *ConflictError
+	require.True(t, errors.As(err, &conflict))
+
+	diagnosis, err := store.TransitionServiceOrderTx(context.Background(), TransitionServiceOrderTxParams{
+		ID:    order.ID,
+		State: ServiceOrderDiagnosis,
+	})
+	require.NoError(t, err)
+	require.Equal(t, ServiceOrderDiagnosis, diagnosis.State)
+	require.Equal(t, order.Version+1, diagnosis.Version)
+	require.False(t, diagnosis.DateReturned.Valid)
+}
+
+func TestTransitionServiceOrderTxInvoiced(t *testing.T) {
+	store := NewStore(testDB)
+	order := readyServiceOrder(t)
+	createRandomSaleInvoice(t, order)
+
+	// an invoiced order is not reopened nor cancelled
+	var conflict *ConflictError
+	for _, state := range []int32{ServiceOrderInProgress, ServiceOrderCancelled} {
+		_, err := store.TransitionServiceOrderTx(context.Background(), TransitionServiceOrderTxParams{
+			ID:    order.ID,
+			State: state,
+		})
+		require.True(t, errors.As(err, &conflict))
+	}
+
+	_, err := store.AddPartDetailTx(context.Background(), AddPartDetailTxParams{
+		ServiceOrderID: order.ID,
+		PartID:         stockPart(t, 1).ID,
+		Quantity:       1,
+	})
+	require.True(t, errors.As(err, &conflict))
+
+	returned, err := store.TransitionServiceOrderTx(context.Background(), TransitionServiceOrderTxParams{
+		ID:    order.ID,
+		State: ServiceOrderReturned,
+	})
+	require.NoError(t, err)
+	require.Equal(t, ServiceOrderReturned, returned.State)
+	require.True(t, returned.DateReturned.Valid)
+}
+
+func TestDeleteServiceOrderTx(t *testing.T) {
+	store := NewStore(testDB)
+	order := createRandomServiceOrder(t, createRandomCar(t, createRandomCustomer(t)))
+
+	err := store.DeleteServiceOrderTx(context.Background(), DeleteServiceOrderParams{
+		ID:       order.ID,
+		Versions: []int32{order.Version + 1},
+	})
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+
+	err = store.DeleteServiceOrderTx(context.Background(), DeleteServiceOrderParams{ID: order.ID})
+	require.NoError(t, err)
+
+	_, err = store.GetServiceOrder(context.Background(), order.ID)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+
+	err = store.DeleteServiceOrderTx(context.Background(), DeleteServiceOrderParams{ID: order.ID})
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+}
+
+func TestDeleteServiceOrderTxInvoiced(t *testing.T) {
+	store := NewStore(testDB)
+	order := readyServiceOrder(t)
+	invoice := createRandomSaleInvoice(t, order)
+
+	err := store.DeleteServiceOrderTx(context.Background(), DeleteServiceOrderParams{ID: order.ID})
+	var conflict *ConflictError
+	require.True(t, errors.As(err, &conflict))
+
+	_, err = store.GetSaleInvoice(context.Background(), invoice.ID)
+	require.NoError(t, err)
+}
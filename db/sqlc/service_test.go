@@ -0,0 +1,115 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomService(t *testing.T) Service {
+	arg := CreateServiceParams{
+		Name:           util.RandomString(12),
+		Description:    util.RandomString(30),
+		EstimationTime: int32(util.RandomInt(15, 240)),
+		MinPrice:       sql.NullString{String: "1000.00", Valid: true},
+		MaxPrice:       sql.NullString{String: "2000.00", Valid: true},
+	}
+
+	service, err := testQueries.CreateService(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, service)
+
+	require.Equal(t, arg.Name, service.Name)
+	require.Equal(t, arg.Description, service.Description)
+	require.Equal(t, arg.EstimationTime, service.EstimationTime)
+	require.Equal(t, arg.MinPrice, service.MinPrice)
+	require.Equal(t, arg.MaxPrice, service.MaxPrice)
+
+	require.NotZero(t, service.ID)
+
+	return service
+}
+
+func TestCreateService(t *testing.T) {
+	createRandomService(t)
+}
+
+func TestCreateServiceInvalidRange(t *testing.T) {
+	_, err := testQueries.CreateService(context.Background(), CreateServiceParams{
+		Name:           util.RandomString(12),
+		Description:    util.RandomString(30),
+		EstimationTime: 30,
+		MinPrice:       sql.NullString{String: "2000.00", Valid: true},
+		MaxPrice:       sql.NullString{String: "1000.00", Valid: true},
+	})
+	require.Error(t, err)
+}
+
+func TestGetService(t *testing.T) {
+	service1 := createRandomService(t)
+	service2, err := testQueries.GetService(context.Background(), service1.ID)
+	require.NoError(t, err)
+	require.Equal(t, service1, service2)
+}
+
+func TestUpdateService(t *testing.T) {
+	service1 := createRandomService(t)
+
+	arg := UpdateServiceParams{
+		ID:             service1.ID,
+		Name:           service1.Name,
+		Description:    util.RandomString(30),
+		EstimationTime: service1.EstimationTime + 15,
+		MinPrice:       service1.MinPrice,
+		MaxPrice:       service1.MinPrice,
+	}
+
+	service2, err := testQueries.UpdateService(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, service1.ID, service2.ID)
+	require.Equal(t, arg.Description, service2.Description)
+	require.Equal(t, arg.EstimationTime, service2.EstimationTime)
+	require.Equal(t, arg.MaxPrice, service2.MaxPrice)
+}
+
+func TestDeleteService(t *testing.T) {
+	service1 := createRandomService(t)
+	rows, err := testQueries.DeleteService(context.Background(), service1.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rows)
+
+	_, err = testQueries.GetService(context.Background(), service1.ID)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+}
+
+func TestDeleteServiceInUse(t *testing.T) {
+	store := NewStore(testDB)
+
+	service := createRandomService(t)
+	order := createRandomServiceOrder(t, createRandomCar(t, createRandomCustomer(t)))
+	_, err := store.AddServiceDetailTx(context.Background(), AddServiceDetailTxParams{
+		ServiceOrderID: order.ID,
+		ServiceID:      service.ID,
+	})
+	require.NoError(t, err)
+
+	_, err = testQueries.DeleteService(context.Background(), service.ID)
+	require.Error(t, err)
+}
+
+func TestListServices(t *testing.T) {
+	for i := 0; i < 10; i++ {
+		createRandomService(t)
+	}
+
+	services, err := testQueries.ListServices(context.Background(), ListServicesParams{Limit: 5})
+	require.NoError(t, err)
+	require.Len(t, services, 5)
+
+	count, err := testQueries.CountServices(context.Background())
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, count, int64(10))
+}
@@ -0,0 +1,87 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// Store provides all functions to execute db queries and transactions
+type Store interface {
+	Querier
+	CreatePurchaseInvoiceTx(ctx context.Context, arg CreatePurchaseInvoiceTxParams) (CreatePurchaseInvoiceTxResult, error)
+	AddPurchaseDetailTx(ctx context.Context, arg CreatePurchaseDetailParams) (PurchaseDetail, error)
+	UpdatePurchaseDetailTx(ctx context.Context, arg UpdatePurchaseDetailParams) (PurchaseDetail, error)
+	DeletePurchaseDetailTx(ctx context.Context, arg DeletePurchaseDetailParams) error
+	DeletePurchaseInvoiceTx(ctx context.Context, id int32) error
+	TransitionServiceOrderTx(ctx context.Context, arg TransitionServiceOrderTxParams) (ServiceOrder, error)
+	DeleteServiceOrderTx(ctx context.Context, arg DeleteServiceOrderParams) error
+	AddPartDetailTx(ctx context.Context, arg AddPartDetailTxParams) (PartDetail, error)
+	DeletePartDetailTx(ctx context.Context, arg DeletePartDetailParams) error
+	AddServiceDetailTx(ctx context.Context, arg AddServiceDetailTxParams) (ServiceDetail, error)
+	DeleteServiceDetailTx(ctx context.Context, arg DeleteServiceDetailParams) error
+	TransitionServiceDetailTx(ctx context.Context, arg TransitionServiceDetailTxParams) (TransitionServiceDetailTxResult, error)
+	ClockInTx(ctx context.Context, arg ClockInTxParams) (LaborEntry, error)
+	BookAppointmentTx(ctx context.Context, arg BookAppointmentTxParams) (BookAppointmentTxResult, error)
+	ArriveAppointmentTx(ctx context.Context, arg ArriveAppointmentTxParams) (ArriveAppointmentTxResult, error)
+	CreateSaleInvoiceTx(ctx context.Context, serviceOrderID int32) (CreateSaleInvoiceTxResult, error)
+	CreateFirstAdminTx(ctx context.Context, arg CreateFirstAdminParams) (User, error)
+	DeleteCustomerTx(ctx context.Context, arg SoftDeleteCustomerParams) error
+	RestoreCustomerTx(ctx context.Context, id int64) (Customer, error)
+	RestoreCarTx(ctx context.Context, id int32) (Car, error)
+	PurgeCustomerTx(ctx context.Context, id int64) error
+	ListCustomersWhere(ctx context.Context, arg ListParams) ([]Customer, error)
+	CountCustomersWhere(ctx context.Context, where []Condition) (int64, error)
+	ListCarsWhere(ctx context.Context, arg ListParams) ([]Car, error)
+	CountCarsWhere(ctx context.Context, where []Condition) (int64, error)
+	ListServiceOrdersWhere(ctx context.Context, arg ListParams) ([]ServiceOrder, error)
+	CountServiceOrdersWhere(ctx context.Context, where []Condition) (int64, error)
+	ListPartsWhere(ctx context.Context, arg ListParams) ([]Part, error)
+	CountPartsWhere(ctx context.Context, where []Condition) (int64, error)
+}
+
+// SQLStore provides all functions to execute SQL queries and transactions
+type SQLStore struct {
+	*Queries
+	db *sql.DB
+}
+
+// NewStore creates a new Store
+func NewStore(db *sql.DB) Store {
+	return &SQLStore{
+		db:      db,
+		Queries: New(db),
+	}
+}
+
+// ConflictError is returned by a transaction refused because of the current state of the data,
+// such as using more of a part than is in stock
+type ConflictError struct {
+	msg string
+}
+
+func (e *ConflictError) Error() string {
+	return e.msg
+}
+
+func conflictf(format string, a ...interface{}) error {
+	return &ConflictError{msg: fmt.Sprintf(format, a...)}
+}
+
+// execTx executes a function within a database transaction
+func (store *SQLStore) execTx(ctx context.Context, fn func(*Queries) error) error {
+	tx, err := store.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	err = fn(store.WithTx(tx))
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %w, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,247 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// stockPart creates a part with quantity units purchased
+func stockPart(t *testing.T, quantity int32) Part {
+	part := createRandomPart(t)
+	invoice := createRandomPurchaseInvoice(t, createRandomSupplier(t))
+	_, err := testQueries.CreatePurchaseDetail(context.Background(), CreatePurchaseDetailParams{
+		PartID:            part.ID,
+		PurchaseInvoiceID: invoice.ID,
+		Quantity:          quantity,
+		Price:             "1.00",
+	})
+	require.NoError(t, err)
+	return part
+}
+
+func TestAddPartDetailTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	order := createRandomServiceOrder(t, createRandomCar(t, createRandomCustomer(t)))
+	part := stockPart(t, 5)
+
+	// run n concurrent consumptions of 2 units, only 2 of them fit in the stock
+	n := 5
+	errs := make(chan error)
+	for i := 0; i < n; i++ {
+		go func() {
+			_, err := store.AddPartDetailTx(context.Background(), AddPartDetailTxParams{
+				ServiceOrderID: order.ID,
+				PartID:         part.ID,
+				Quantity:       2,
+			})
+			errs <- err
+		}()
+	}
+
+	var added, refused int
+	for i := 0; i < n; i++ {
+		err := <-errs
+		var conflict *ConflictError
+		switch {
+		case err == nil:
+			added++
+		case errors.As(err, &conflict):
+			refused++
+		default:
+			require.NoError(t, err)
+		}
+	}
+	require.Equal(t, 2, added)
+	require.Equal(t, n-2, refused)
+
+	stock, err := testQueries.GetPartStock(context.Background(), part.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), stock.OnHand)
+
+	details, err := testQueries.ListServiceOrderParts(context.Background(), order.ID)
+	require.NoError(t, err)
+	require.Len(t, details, 2)
+	require.Equal(t, part.RetailPrice, details[0].Price.String)
+}
+
+func TestDeletePartDetailTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	order := createRandomServiceOrder(t, createRandomCar(t, createRandomCustomer(t)))
+	part := stockPart(t, 2)
+	_, err := store.AddPartDetailTx(context.Background(), AddPartDetailTxParams{
+		ServiceOrderID: order.ID,
+		PartID:         part.ID,
+		Quantity:       2,
+	})
+	require.NoError(t, err)
+
+	order, err = testQueries.UpdateServiceOrderState(context.Background(), UpdateServiceOrderStateParams{
+		ID:        order.ID,
+		State:     ServiceOrderReady,
+		FromState: order.State,
+	})
+	require.NoError(t, err)
+	createRandomSaleInvoice(t, order)
+
+	// the line of an invoiced order is kept, as the invoice charges for it
+	arg := DeletePartDetailParams{ServiceOrderID: order.ID, PartID: part.ID}
+	err = store.DeletePartDetailTx(context.Background(), arg)
+	var conflict *ConflictError
+	require.ErrorAs(t, err, &conflict)
+
+	other := createRandomServiceOrder(t, createRandomCar(t, createRandomCustomer(t)))
+	err = store.DeletePartDetailTx(context.Background(), DeletePartDetailParams{ServiceOrderID: other.ID, PartID: part.ID})
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+
+	stock, err := testQueries.GetPartStock(context.Background(), part.ID)
+	require.NoError(t, err)
+	require.Zero(t, stock.OnHand)
+}
+
+func TestCreatePurchaseInvoiceTx(t *testing.T) {
+	store := NewStore(testDB)
+	supplier := createRandomSupplier(t)
+	part := createRandomPart(t)
+
+	arg := CreatePurchaseInvoiceTxParams{
+		SupplierID: supplier.ID,
+		Ref:        "FA-" + part.Name,
+		Lines: []CreatePurchaseDetailParams{
+			{PartID: part.ID, Quantity: 2, Price: "10.50"},
+			{PartID: part.ID, Quantity: 1, Price: "3.00"},
+		},
+	}
+	result, err := store.CreatePurchaseInvoiceTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, supplier.ID, result.Invoice.SupplierID)
+	require.Equal(t, "24.00", result.Invoice.Total)
+	require.Len(t, result.Lines, 2)
+
+	line, err := store.AddPurchaseDetailTx(context.Background(), CreatePurchaseDetailParams{
+		PurchaseInvoiceID: result.Invoice.ID,
+		PartID:            part.ID,
+		Quantity:          1,
+		Price:             "1.00",
+	})
+	require.NoError(t, err)
+	require.Equal(t, result.Invoice.ID, line.PurchaseInvoiceID)
+
+	deleted := createRandomPurchaseInvoice(t, supplier)
+	err = store.DeletePurchaseInvoiceTx(context.Background(), deleted.ID)
+	require.NoError(t, err)
+
+	_, err = store.AddPurchaseDetailTx(context.Background(), CreatePurchaseDetailParams{
+		PurchaseInvoiceID: deleted.ID,
+		PartID:            part.ID,
+		Quantity:          1,
+		Price:             "1.00",
+	})
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+
+	// a refused line rolls the whole invoice back
+	arg.Ref = "FA-refused-" + part.Name
+	arg.Lines = append(arg.Lines, CreatePurchaseDetailParams{PartID: part.ID, Quantity: 0, Price: "1.00"})
+	_, err = store.CreatePurchaseInvoiceTx(context.Background(), arg)
+	require.Error(t, err)
+
+	stock, err := testQueries.GetPartStock(context.Background(), part.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), stock.Purchased)
+}
+
+func TestCreateSaleInvoiceTx(t *testing.T) {
+	store := NewStore(testDB)
+	order := createRandomServiceOrder(t, createRandomCar(t, createRandomCustomer(t)))
+
+	_, err := store.CreateSaleInvoiceTx(context.Background(), order.ID)
+	var conflict *ConflictError
+	require.ErrorAs(t, err, &conflict)
+
+	part := stockPart(t, 3)
+	_, err = store.AddPartDetailTx(context.Background(), AddPartDetailTxParams{
+		ServiceOrderID: order.ID,
+		PartID:         part.ID,
+		Quantity:       3,
+		Price:          "2.50",
+	})
+	require.NoError(t, err)
+
+	_, err = testQueries.UpdateServiceOrderState(context.Background(), UpdateServiceOrderStateParams{
+		ID:        order.ID,
+		State:     ServiceOrderReady,
+		FromState: order.State,
+	})
+	require.NoError(t, err)
+
+	result, err := store.CreateSaleInvoiceTx(context.Background(), order.ID)
+	require.NoError(t, err)
+	require.Equal(t, order.ID, result.Invoice.ServiceOrderID)
+	require.Equal(t, "7.50", result.Invoice.Total)
+	require.Len(t, result.Lines, 1)
+
+	_, err = store.CreateSaleInvoiceTx(context.Background(), order.ID)
+	require.ErrorAs(t, err, &conflict)
+}
+
+func TestPurchaseEditsKeepStockOnHand(t *testing.T) {
+	store := NewStore(testDB)
+	order := createRandomServiceOrder(t, createRandomCar(t, createRandomCustomer(t)))
+	part := createRandomPart(t)
+
+	result, err := store.CreatePurchaseInvoiceTx(context.Background(), CreatePurchaseInvoiceTxParams{
+		SupplierID: createRandomSupplier(t).ID,
+		Ref:        "FA-" + part.Name,
+		Lines:      []CreatePurchaseDetailParams{{PartID: part.ID, Quantity: 5, Price: "1.00"}},
+	})
+	require.NoError(t, err)
+	line := result.Lines[0]
+
+	_, err = store.AddPartDetailTx(context.Background(), AddPartDetailTxParams{
+		ServiceOrderID: order.ID,
+		PartID:         part.ID,
+		Quantity:       3,
+	})
+	require.NoError(t, err)
+
+	// 3 of the 5 purchased are used, the purchase cannot go below 3
+	var conflict *ConflictError
+	arg := UpdatePurchaseDetailParams{
+		ID:                line.ID,
+		PurchaseInvoiceID: line.PurchaseInvoiceID,
+		PartID:            part.ID,
+		Quantity:          2,
+		Price:             line.Price,
+	}
+	_, err = store.UpdatePurchaseDetailTx(context.Background(), arg)
+	require.True(t, errors.As(err, &conflict))
+
+	arg.PartID = createRandomPart(t).ID
+	arg.Quantity = line.Quantity
+	_, err = store.UpdatePurchaseDetailTx(context.Background(), arg)
+	require.True(t, errors.As(err, &conflict))
+
+	err = store.DeletePurchaseDetailTx(context.Background(), DeletePurchaseDetailParams{
+		ID:                line.ID,
+		PurchaseInvoiceID: line.PurchaseInvoiceID,
+	})
+	require.True(t, errors.As(err, &conflict))
+
+	err = store.DeletePurchaseInvoiceTx(context.Background(), line.PurchaseInvoiceID)
+	require.True(t, errors.As(err, &conflict))
+
+	arg.PartID = part.ID
+	arg.Quantity = 3
+	updated, err := store.UpdatePurchaseDetailTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, int32(3), updated.Quantity)
+
+	stock, err := testQueries.GetPartStock(context.Background(), part.ID)
+	require.NoError(t, err)
+	require.Zero(t, stock.OnHand)
+}
@@ -0,0 +1,91 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomSupplier(t *testing.T) Supplier {
+
+	arg := CreateSupplierParams{
+		Name:        util.RandomString(12),
+		Address:     sql.NullString{String: util.RandomString(20), Valid: true},
+		PhoneNumber: util.RandomString(10),
+	}
+
+	supplier, err := testQueries.CreateSupplier(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, supplier)
+
+	require.Equal(t, arg.Name, supplier.Name)
+	require.Equal(t, arg.Address, supplier.Address)
+	require.Equal(t, arg.PhoneNumber, supplier.PhoneNumber)
+
+	require.NotZero(t, supplier.ID)
+
+	return supplier
+}
+
+func TestCreateSupplier(t *testing.T) {
+	createRandomSupplier(t)
+}
+
+func TestGetSupplier(t *testing.T) {
+	supplier1 := createRandomSupplier(t)
+	supplier2, err := testQueries.GetSupplier(context.Background(), supplier1.ID)
+	require.NoError(t, err)
+	require.Equal(t, supplier1, supplier2)
+}
+
+func TestUpdateSupplier(t *testing.T) {
+	supplier1 := createRandomSupplier(t)
+
+	arg := UpdateSupplierParams{
+		ID:          supplier1.ID,
+		Name:        util.RandomString(12),
+		PhoneNumber: supplier1.PhoneNumber,
+	}
+
+	supplier2, err := testQueries.UpdateSupplier(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, supplier1.ID, supplier2.ID)
+	require.Equal(t, arg.Name, supplier2.Name)
+	require.False(t, supplier2.Address.Valid)
+}
+
+func TestDeleteSupplier(t *testing.T) {
+	supplier1 := createRandomSupplier(t)
+	rows, err := testQueries.DeleteSupplier(context.Background(), supplier1.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rows)
+
+	supplier2, err := testQueries.GetSupplier(context.Background(), supplier1.ID)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+	require.Empty(t, supplier2)
+}
+
+func TestDeleteSupplierWithInvoices(t *testing.T) {
+	invoice := createRandomPurchaseInvoice(t, createRandomSupplier(t))
+
+	_, err := testQueries.DeleteSupplier(context.Background(), invoice.SupplierID)
+	require.Error(t, err)
+}
+
+func TestListSuppliers(t *testing.T) {
+	for i := 0; i < 10; i++ {
+		createRandomSupplier(t)
+	}
+
+	arg := ListSuppliersParams{
+		Limit:   5,
+		AfterID: 0,
+	}
+
+	suppliers, err := testQueries.ListSuppliers(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, suppliers, 5)
+}
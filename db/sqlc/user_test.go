@@ -0,0 +1,73 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomUser(t *testing.T, role string) User {
+	hashedPassword, err := util.HashPassword(util.RandomString(6))
+	require.NoError(t, err)
+
+	arg := CreateUserParams{
+		Username:       util.RandomName() + util.RandomString(6),
+		HashedPassword: hashedPassword,
+		FullName:       util.RandomName(),
+		Role:           role,
+	}
+
+	user, err := testQueries.CreateUser(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, user)
+
+	require.Equal(t, arg.Username, user.Username)
+	require.Equal(t, arg.HashedPassword, user.HashedPassword)
+	require.Equal(t, arg.FullName, user.FullName)
+	require.Equal(t, arg.Role, user.Role)
+	require.True(t, user.PasswordChangedAt.IsZero())
+	require.NotZero(t, user.CreatedAt)
+
+	return user
+}
+
+func TestCreateUser(t *testing.T) {
+	createRandomUser(t, util.RoleFrontDesk)
+
+	_, err := testQueries.CreateUser(context.Background(), CreateUserParams{
+		Username:       util.RandomName() + util.RandomString(6),
+		HashedPassword: util.RandomString(10),
+		FullName:       util.RandomName(),
+		Role:           "owner",
+	})
+	require.Error(t, err)
+}
+
+func TestGetUser(t *testing.T) {
+	user1 := createRandomUser(t, util.RoleMechanic)
+	user2, err := testQueries.GetUser(context.Background(), user1.Username)
+	require.NoError(t, err)
+	require.NotEmpty(t, user2)
+
+	require.Equal(t, user1.Username, user2.Username)
+	require.Equal(t, user1.HashedPassword, user2.HashedPassword)
+	require.Equal(t, user1.FullName, user2.FullName)
+	require.Equal(t, user1.Role, user2.Role)
+	require.WithinDuration(t, user1.CreatedAt, user2.CreatedAt, time.Second)
+}
+
+func TestCreateFirstAdmin(t *testing.T) {
+	// the other tests create users, so the first admin is always refused here
+	createRandomUser(t, util.RoleAdmin)
+
+	_, err := testQueries.CreateFirstAdmin(context.Background(), CreateFirstAdminParams{
+		Username:       util.RandomName() + util.RandomString(6),
+		HashedPassword: util.RandomString(10),
+		FullName:       util.RandomName(),
+	})
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+}
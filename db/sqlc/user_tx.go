@@ -0,0 +1,28 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+// CreateFirstAdminTx creates the first admin of a new installation. The users are locked so two
+// concurrent setups cannot both find no user and create two admins. A ConflictError is returned
+// once any user exists.
+func (store *SQLStore) CreateFirstAdminTx(ctx context.Context, arg CreateFirstAdminParams) (User, error) {
+	var user User
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		if err := q.LockUsers(ctx); err != nil {
+			return err
+		}
+
+		var err error
+		user, err = q.CreateFirstAdmin(ctx, arg)
+		if err == sql.ErrNoRows {
+			return conflictf("the first admin already exists, ask an admin to create your user")
+		}
+		return err
+	})
+
+	return user, err
+}
@@ -0,0 +1,23 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateFirstAdminTx(t *testing.T) {
+	store := NewStore(testDB)
+	createRandomUser(t, util.RoleAdmin)
+
+	_, err := store.CreateFirstAdminTx(context.Background(), CreateFirstAdminParams{
+		Username:       util.RandomName() + util.RandomString(6),
+		HashedPassword: util.RandomString(10),
+		FullName:       util.RandomName(),
+	})
+	var conflict *ConflictError
+	require.True(t, errors.As(err, &conflict))
+}
@@ -16,9 +16,14 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/customers": {
-            "get": {
-                "description": "Create GET list of all Customers",
+        "/appointments": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "book a bay for a Car of a Customer, for the time the Services requested take.\nThe Car cannot be booked twice at the same time and a bay holds one Car at a time.",
                 "consumes": [
                     "application/json"
                 ],
@@ -26,18 +31,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Customer"
+                    "Appointment"
                 ],
-                "summary": "list all Customers",
-                "operationId": "list-Customer",
+                "summary": "book an Appointment",
+                "operationId": "create-Appointment",
                 "parameters": [
                     {
-                        "description": "The body to list all Customers by pagination",
+                        "description": "The body to book an Appointment",
                         "name": "Body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api.ListCustomersRequest"
+                            "$ref": "#/definitions/api.createAppointmentRequest"
                         }
                     }
                 ],
@@ -45,7 +50,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/CustomersResponse"
+                            "$ref": "#/definitions/api.AppointmentResponse"
                         }
                     },
                     "400": {
@@ -60,6 +65,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -67,9 +78,72 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/appointments/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET  Appointment by it's id, with the Services requested",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Appointment"
+                ],
+                "summary": "GET Appointment",
+                "operationId": "get-Appointment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id to get an Appointment",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.AppointmentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/appointments/{id}/arrive": {
             "post": {
-                "description": "Create a new Customer",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "open a Service Order for the Car of a booked Appointment, with a line for every Service requested",
                 "consumes": [
                     "application/json"
                 ],
@@ -77,18 +151,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Customer"
+                    "Appointment"
                 ],
-                "summary": "Create new Customer",
-                "operationId": "create-Customer",
+                "summary": "receive the Car of an Appointment",
+                "operationId": "arrive-Appointment",
                 "parameters": [
                     {
-                        "description": "The body to create a Customer",
+                        "type": "string",
+                        "description": "The id of the Appointment",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The state of the Car on arrival",
                         "name": "Body",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api.createCustomerRequest"
+                            "$ref": "#/definitions/api.arriveAppointmentRequest"
                         }
                     }
                 ],
@@ -96,7 +176,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.CustomerResponse"
+                            "$ref": "#/definitions/api.ArriveAppointmentResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "The version of the Service Order, to send in If-Match"
+                            }
                         }
                     },
                     "400": {
@@ -105,6 +191,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -114,9 +212,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/customers/{id}": {
-            "get": {
-                "description": "GET  Customer by it's id",
+        "/appointments/{id}/cancel": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "cancel a booked Appointment, its bay is free again",
                 "consumes": [
                     "application/json"
                 ],
@@ -124,14 +227,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Customer"
+                    "Appointment"
                 ],
-                "summary": "GET Customer",
-                "operationId": "get-Customer",
+                "summary": "cancel an Appointment",
+                "operationId": "cancel-Appointment",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "The id to get a Customer",
+                        "description": "The id of the Appointment",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -141,7 +244,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.CustomerResponse"
+                            "$ref": "#/definitions/api.AppointmentResponse"
                         }
                     },
                     "400": {
@@ -150,6 +253,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -157,9 +272,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "update a  Customer",
+            }
+        },
+        "/cars": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET list of all Cars",
                 "consumes": [
                     "application/json"
                 ],
@@ -167,33 +289,198 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Customer"
+                    "Car"
                 ],
-                "summary": "update  Customer",
-                "operationId": "update-Customer",
+                "summary": "list all Cars",
+                "operationId": "list-Car",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "The id to get a Customer",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "The next_cursor of the previous page, none for the first page",
+                        "name": "cursor",
+                        "in": "query"
                     },
                     {
-                        "description": "The body to create a Customer",
+                        "type": "integer",
+                        "description": "The number of Cars per page, 20 by default",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The field to sort by, descending when prefixed with a -: customer_id, make, model, year or id (default)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Only the Cars of this Customer",
+                        "name": "customer_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only the Cars whose make starts with it, ignoring case",
+                        "name": "make_prefix",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only the Cars whose model starts with it, ignoring case",
+                        "name": "model_prefix",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only the Cars whose registration number starts with it, ignoring case",
+                        "name": "registration_prefix",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only the Cars of this energy",
+                        "name": "energy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only the Cars of this year",
+                        "name": "year",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/api.PageResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/api.CarResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Register a new Car against a Customer",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Car"
+                ],
+                "summary": "Create new Car",
+                "operationId": "create-Car",
+                "parameters": [
+                    {
+                        "description": "The body to create a Car",
                         "name": "Body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api.createCustomerRequest"
+                            "$ref": "#/definitions/api.createCarRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.CarResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "The version of the Car, to send in If-Match"
+                            }
                         }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cars/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET  Car by it's id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Car"
+                ],
+                "summary": "GET Car",
+                "operationId": "get-Car",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id to get a Car",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/CustomersResponse"
+                            "$ref": "#/definitions/api.CarResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "The version of the Car, to send in If-Match"
+                            }
                         }
                     },
                     "400": {
@@ -216,8 +503,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
-                "description": "use this api to delete a customer by it's id",
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "update a  Car",
                 "consumes": [
                     "application/json"
                 ],
@@ -225,24 +517,45 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Customer"
+                    "Car"
                 ],
-                "summary": "DELETE a Customer",
-                "operationId": "delete-Customer",
+                "summary": "update  Car",
+                "operationId": "update-Car",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "The id to delete a Customer",
+                        "description": "The id to update a Car",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "The body to update a Car",
+                        "name": "Body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.updateCarRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "The ETag of the version to change, the request fails with a 412 when the Car changed since",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/api.CarResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "The version of the Car, to send in If-Match"
+                            }
                         }
                     },
                     "400": {
@@ -257,76 +570,6481 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "412": {
+                        "description": "Precondition Failed",
                         "schema": {
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
-                    }
-                }
-            }
-        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "use this api to delete a car by it's id, the car is hidden until it is restored",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Car"
+                ],
+                "summary": "DELETE a Car",
+                "operationId": "delete-Car",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id to delete a Car",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The ETag of the version to delete, the request fails with a 412 when the Car changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cars/{id}/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET the Service Orders of a Car, oldest first, with the Services done, the Parts used, the Mechanics,\nthe mileage when the Car was received and the invoice refs. The history of a deleted Car is still returned.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Car"
+                ],
+                "summary": "GET the service history of a Car",
+                "operationId": "get-Car-history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id of the Car",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.ServiceOrderHistoryResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cars/{id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "restore a deleted Car, the Car of a deleted Customer is restored with the Customer. A Car that is not deleted is a 409.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Car"
+                ],
+                "summary": "restore a deleted Car",
+                "operationId": "restore-Car",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id of the deleted Car",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.CarResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "The version of the Car, to send in If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/customers": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create GET list of all Customers",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Customer"
+                ],
+                "summary": "list all Customers",
+                "operationId": "list-Customer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The next_cursor of the previous page, none for the first page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of Customers per page, 20 by default",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The field to sort by, descending when prefixed with a -: full_name, created_at or id (default)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only the Customers whose name starts with it, ignoring case",
+                        "name": "name_prefix",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only the Customers created on or after this date or RFC 3339 time",
+                        "name": "created_after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only the Customers created before this date or RFC 3339 time",
+                        "name": "created_before",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/api.PageResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/api.CustomerResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new Customer",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Customer"
+                ],
+                "summary": "Create new Customer",
+                "operationId": "create-Customer",
+                "parameters": [
+                    {
+                        "description": "The body to create a Customer",
+                        "name": "Body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.createCustomerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.CustomerResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "The version of the Customer, to send in If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/customers/search": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Find the Customers whose name or phone number contains the query, ignoring case and the spaces, dashes or leading 0 of a phone number, the closest matches first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Customer"
+                ],
+                "summary": "search Customers",
+                "operationId": "search-Customer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "A part of the name or of the phone number",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of Customers to return, 10 by default",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.CustomerResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/customers/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET  Customer by it's id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Customer"
+                ],
+                "summary": "GET Customer",
+                "operationId": "get-Customer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id to get a Customer",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.CustomerResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "The version of the Customer, to send in If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "replace all the fields of a Customer, use PATCH to change some of them",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Customer"
+                ],
+                "summary": "replace  Customer",
+                "operationId": "update-Customer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id to update a Customer",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The body to replace a Customer",
+                        "name": "Body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.updateCustomerRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "The ETag of the version to change, the request fails with a 412 when the Customer changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.CustomerResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "The version of the Customer, to send in If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "use this api to delete a customer by it's id, the customer and its cars are hidden until it is restored",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Customer"
+                ],
+                "summary": "DELETE a Customer",
+                "operationId": "delete-Customer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id to delete a Customer",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The ETag of the version to delete, the request fails with a 412 when the Customer changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "change some fields of a Customer with a JSON merge patch (RFC 7396): the fields omitted are left unchanged, none can be removed with null",
+                "consumes": [
+                    "application/json",
+                    "application/merge-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Customer"
+                ],
+                "summary": "patch  Customer",
+                "operationId": "patch-Customer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id to patch a Customer",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The fields to change",
+                        "name": "Body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.patchCustomerRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "The ETag of the version to change, the request fails with a 412 when the Customer changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.CustomerResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "The version of the Customer, to send in If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/customers/{id}/cars": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET list of all Cars registered against a Customer",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Car"
+                ],
+                "summary": "list the Cars of a Customer",
+                "operationId": "list-Customer-Car",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id of the Customer",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.CarResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/customers/{id}/purge": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "delete a Customer, deleted or not, for good with its Cars and their Service Orders. A Customer with invoices cannot be purged.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Customer"
+                ],
+                "summary": "purge a Customer",
+                "operationId": "purge-Customer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id of the Customer to purge",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/customers/{id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "restore a deleted Customer with the Cars deleted along with it. A Customer that is not deleted is a 409.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Customer"
+                ],
+                "summary": "restore a deleted Customer",
+                "operationId": "restore-Customer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id of the deleted Customer",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.CustomerResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "The version of the Customer, to send in If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/invoices": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET list of all Sale Invoices, without their lines",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SaleInvoice"
+                ],
+                "summary": "list all Sale Invoices",
+                "operationId": "list-SaleInvoice",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The next_cursor of the previous page, none for the first page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of Sale Invoices per page, 20 by default",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/api.PageResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/api.SaleInvoiceResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/invoices/outstanding": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET the Sale Invoices with an outstanding balance, oldest first, with the amount outstanding\naged in 0-30, 31-60 and 60+ days buckets",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payment"
+                ],
+                "summary": "list the unpaid Sale Invoices",
+                "operationId": "outstanding-SaleInvoice",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.OutstandingInvoicesResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/invoices/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET  Sale Invoice by it's id, with its lines",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SaleInvoice"
+                ],
+                "summary": "GET Sale Invoice",
+                "operationId": "get-SaleInvoice",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id to get a Sale Invoice",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.SaleInvoiceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/invoices/{id}/balance": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET the total, amount paid, outstanding amount and payment status (unpaid, partial, paid or overpaid)\nof a Sale Invoice",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payment"
+                ],
+                "summary": "GET the balance of a Sale Invoice",
+                "operationId": "balance-SaleInvoice",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id of the Sale Invoice",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.SaleInvoiceBalanceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/invoices/{id}/payments": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET the Payments recorded against a Sale Invoice, oldest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payment"
+                ],
+                "summary": "list the Payments of a Sale Invoice",
+                "operationId": "list-Payment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id of the Sale Invoice",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/db.Payment"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "record a full or partial Payment against a Sale Invoice, paying more than the outstanding balance\nis accepted and shows the invoice as overpaid",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payment"
+                ],
+                "summary": "record a Payment",
+                "operationId": "create-Payment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id of the Sale Invoice",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The Payment",
+                        "name": "Body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.createPaymentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/db.Payment"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/invoices/{id}/payments/{payment_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "remove a Payment recorded by mistake from a Sale Invoice",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payment"
+                ],
+                "summary": "DELETE a Payment",
+                "operationId": "delete-Payment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id of the Sale Invoice",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The id of the Payment",
+                        "name": "payment_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/mechanics": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET list of all Mechanics",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mechanic"
+                ],
+                "summary": "list all Mechanics",
+                "operationId": "list-Mechanic",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The next_cursor of the previous page, none for the first page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of Mechanics per page, 20 by default",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/api.PageResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/db.Mechanic"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new Mechanic",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mechanic"
+                ],
+                "summary": "Create new Mechanic",
+                "operationId": "create-Mechanic",
+                "parameters": [
+                    {
+                        "description": "The body to create a Mechanic",
+                        "name": "Body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.createMechanicRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/db.Mechanic"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/mechanics/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET  Mechanic by it's id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mechanic"
+                ],
+                "summary": "GET Mechanic",
+                "operationId": "get-Mechanic",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id to get a Mechanic",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/db.Mechanic"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "update a  Mechanic",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mechanic"
+                ],
+                "summary": "update  Mechanic",
+                "operationId": "update-Mechanic",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id to update a Mechanic",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The body to update a Mechanic",
+                        "name": "Body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.updateMechanicRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/db.Mechanic"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "use this api to delete a mechanic by it's id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mechanic"
+                ],
+                "summary": "DELETE a Mechanic",
+                "operationId": "delete-Mechanic",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id to delete a Mechanic",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/mechanics/{id}/clock-in": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "start the time clock of a Mechanic on a Service line of an open Service Order, a pending or paused line is started.\nA Mechanic is clocked in on one line at a time.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mechanic"
+                ],
+                "summary": "clock a Mechanic in on a Service line",
+                "operationId": "clock-in-Mechanic",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id of the Mechanic",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The Service line worked on",
+                        "name": "Body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.clockInRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.LaborEntryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/mechanics/{id}/clock-out": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "stop the time clock of a Mechanic, the Service line stays started until it is paused or done",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mechanic"
+                ],
+                "summary": "clock a Mechanic out",
+                "operationId": "clock-out-Mechanic",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id of the Mechanic",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.LaborEntryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/mechanics/{id}/workload": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET the Service Orders a Mechanic is assigned to that are neither returned nor cancelled, oldest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mechanic"
+                ],
+                "summary": "list the open Service Orders of a Mechanic",
+                "operationId": "workload-Mechanic",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id of the Mechanic",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.ServiceOrderResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/parts": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET list of all Parts",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Part"
+                ],
+                "summary": "list all Parts",
+                "operationId": "list-Part",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The next_cursor of the previous page, none for the first page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of Parts per page, 20 by default",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The field to sort by, descending when prefixed with a -: name, retail_price, reorder_level or id (default)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only the Parts whose name starts with it, ignoring case",
+                        "name": "name_prefix",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Only the Parts whose retail price is at least this",
+                        "name": "price_min",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Only the Parts whose retail price is at most this",
+                        "name": "price_max",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/api.PageResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/db.Part"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new Part",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Part"
+                ],
+                "summary": "Create new Part",
+                "operationId": "create-Part",
+                "parameters": [
+                    {
+                        "description": "The body to create a Part",
+                        "name": "Body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.createPartRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/db.Part"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/parts/low-stock": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET the Parts whose stock on hand is at or below their reorder level, most needed first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Part"
+                ],
+                "summary": "list the Parts low on stock",
+                "operationId": "low-stock-Part",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/db.PartStock"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/parts/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET  Part by it's id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Part"
+                ],
+                "summary": "GET Part",
+                "operationId": "get-Part",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id to get a Part",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/db.Part"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "update a  Part",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Part"
+                ],
+                "summary": "update  Part",
+                "operationId": "update-Part",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id to update a Part",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The body to update a Part",
+                        "name": "Body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.updatePartRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/db.Part"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "use this api to delete a part by it's id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Part"
+                ],
+                "summary": "DELETE a Part",
+                "operationId": "delete-Part",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id to delete a Part",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/parts/{id}/stock": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET the quantity purchased, used on service orders and on hand of a Part",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Part"
+                ],
+                "summary": "GET the stock of a Part",
+                "operationId": "stock-Part",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id of the Part",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/db.PartStock"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/purchase-invoices": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET list of all Purchase Invoices, without their lines",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PurchaseInvoice"
+                ],
+                "summary": "list all Purchase Invoices",
+                "operationId": "list-PurchaseInvoice",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The next_cursor of the previous page, none for the first page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of Purchase Invoices per page, 20 by default",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/api.PageResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/api.PurchaseInvoiceResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Record a Purchase Invoice from a Supplier with its lines, the purchased Parts are added to stock",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PurchaseInvoice"
+                ],
+                "summary": "Create new Purchase Invoice",
+                "operationId": "create-PurchaseInvoice",
+                "parameters": [
+                    {
+                        "description": "The body to create a Purchase Invoice",
+                        "name": "Body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.createPurchaseInvoiceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.PurchaseInvoiceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/purchase-invoices/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET  Purchase Invoice by it's id, with its lines",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PurchaseInvoice"
+                ],
+                "summary": "GET Purchase Invoice",
+                "operationId": "get-PurchaseInvoice",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id to get a Purchase Invoice",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.PurchaseInvoiceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "update the supplier, reference and date of a Purchase Invoice, use the lines api to change its lines",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PurchaseInvoice"
+                ],
+                "summary": "update  Purchase Invoice",
+                "operationId": "update-PurchaseInvoice",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id to update a Purchase Invoice",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The body to update a Purchase Invoice",
+                        "name": "Body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.updatePurchaseInvoiceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.PurchaseInvoiceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "use this api to delete a purchase invoice and its lines by it's id, refused when parts it purchased were already used",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PurchaseInvoice"
+                ],
+                "summary": "DELETE a Purchase Invoice",
+                "operationId": "delete-PurchaseInvoice",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id to delete a Purchase Invoice",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/purchase-invoices/{id}/lines": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "add a line to a Purchase Invoice, the total of the invoice is updated",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PurchaseInvoice"
+                ],
+                "summary": "add a line to a Purchase Invoice",
+                "operationId": "add-PurchaseDetail",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id of the Purchase Invoice",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The line to add",
+                        "name": "Body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.purchaseDetailRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.PurchaseInvoiceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/purchase-invoices/{id}/lines/{line_id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "update a line of a Purchase Invoice, the total of the invoice is updated. Lowering the quantity or changing the part is refused when the parts were already used.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PurchaseInvoice"
+                ],
+                "summary": "update a line of a Purchase Invoice",
+                "operationId": "update-PurchaseDetail",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id of the Purchase Invoice",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The id of the line",
+                        "name": "line_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The line",
+                        "name": "Body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.purchaseDetailRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.PurchaseInvoiceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "remove a line from a Purchase Invoice, the total of the invoice is updated. Refused when the parts were already used.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PurchaseInvoice"
+                ],
+                "summary": "remove a line from a Purchase Invoice",
+                "operationId": "delete-PurchaseDetail",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id of the Purchase Invoice",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The id of the line",
+                        "name": "line_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/labor": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET, for every Service with lines done in a period, the hours the Mechanics clocked on them and the hours estimated by the catalog, the most underquoted Services first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service"
+                ],
+                "summary": "compare the hours worked with the estimates",
+                "operationId": "labor-report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The first day of the period, as 2006-01-02",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The last day of the period, as 2006-01-02",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.ServiceLaborReportResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/schedule": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET, for every bay of the workshop, the booked and arrived Appointments taking it during a day",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Appointment"
+                ],
+                "summary": "GET the plan of a day",
+                "operationId": "get-Schedule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The day in the timezone of the shop, as 2006-01-02",
+                        "name": "date",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.ScheduleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/service-orders": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET list of all Service Orders",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ServiceOrder"
+                ],
+                "summary": "list all Service Orders",
+                "operationId": "list-ServiceOrder",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The next_cursor of the previous page, none for the first page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of Service Orders per page, 20 by default",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The field to sort by, descending when prefixed with a -: car_id, state or id (default)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Only the Service Orders of this Car",
+                        "name": "car_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "open",
+                            "diagnosis",
+                            "awaiting_parts",
+                            "in_progress",
+                            "ready",
+                            "returned",
+                            "cancelled"
+                        ],
+                        "type": "string",
+                        "description": "Only the Service Orders in this state",
+                        "name": "state",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only the Service Orders received on or after this date or RFC 3339 time",
+                        "name": "received_after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only the Service Orders received before this date or RFC 3339 time",
+                        "name": "received_before",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/api.PageResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/api.ServiceOrderResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Open a new Service Order for a Car, the Car is received today",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ServiceOrder"
+                ],
+                "summary": "Create new Service Order",
+                "operationId": "create-ServiceOrder",
+                "parameters": [
+                    {
+                        "description": "The body to create a Service Order",
+                        "name": "Body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.createServiceOrderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.ServiceOrderResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "The version of the Service Order, to send in If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/service-orders/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET  Service Order by it's id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ServiceOrder"
+                ],
+                "summary": "GET Service Order",
+                "operationId": "get-ServiceOrder",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id to get a Service Order",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.ServiceOrderResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "The version of the Service Order, to send in If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "replace the description and the mileage of a Service Order, use PATCH to change one of them and the transitions api to change its state",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ServiceOrder"
+                ],
+                "summary": "update  Service Order",
+                "operationId": "update-ServiceOrder",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id to update a Service Order",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The body to update a Service Order",
+                        "name": "Body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.updateServiceOrderRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "The ETag of the version to change, the request fails with a 412 when the Service Order changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.ServiceOrderResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "The version of the Service Order, to send in If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "use this api to delete a service order by it's id, an invoiced service order cannot be deleted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ServiceOrder"
+                ],
+                "summary": "DELETE a Service Order",
+                "operationId": "delete-ServiceOrder",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id to delete a Service Order",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The ETag of the version to delete, the request fails with a 412 when the Service Order changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "change the description or the mileage of a Service Order with a JSON merge patch (RFC 7396): the fields omitted are left unchanged, none can be removed with null",
+                "consumes": [
+                    "application/json",
+                    "application/merge-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ServiceOrder"
+                ],
+                "summary": "patch  Service Order",
+                "operationId": "patch-ServiceOrder",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id to patch a Service Order",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The fields to change",
+                        "name": "Body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.patchServiceOrderRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "The ETag of the version to change, the request fails with a 412 when the Service Order changed since",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.ServiceOrderResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "The version of the Service Order, to send in If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/service-orders/{id}/invoice": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Issue the Sale Invoice of a ready or returned Service Order. The service and part lines of the order\nare copied with their current prices so later price changes do not alter the invoice.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SaleInvoice"
+                ],
+                "summary": "invoice a Service Order",
+                "operationId": "create-SaleInvoice",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id of the Service Order",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.SaleInvoiceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/service-orders/{id}/labor": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET the time clock entries of the Mechanics on the Service lines of a Service Order",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mechanic"
+                ],
+                "summary": "list the time worked on a Service Order",
+                "operationId": "list-LaborEntry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id of the Service Order",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.LaborEntryResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/service-orders/{id}/mechanics": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET the Mechanics assigned to a Service Order",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mechanic"
+                ],
+                "summary": "list the Mechanics of a Service Order",
+                "operationId": "list-ServiceOrder-Mechanic",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id of the Service Order",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/db.Mechanic"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "assign a Mechanic to a Service Order that is neither returned nor cancelled",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mechanic"
+                ],
+                "summary": "assign a Mechanic to a Service Order",
+                "operationId": "assign-Mechanic",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id of the Service Order",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The Mechanic to assign",
+                        "name": "Body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.assignMechanicRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/db.MechanicDetail"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/service-orders/{id}/mechanics/{mechanic_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "remove a Mechanic from a Service Order",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mechanic"
+                ],
+                "summary": "unassign a Mechanic from a Service Order",
+                "operationId": "unassign-Mechanic",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id of the Service Order",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The id of the Mechanic",
+                        "name": "mechanic_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/service-orders/{id}/parts": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET the Part lines of a Service Order",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Part"
+                ],
+                "summary": "list the Parts used on a Service Order",
+                "operationId": "list-PartDetail",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id of the Service Order",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.PartDetailResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "add a Part line to a Service Order, refused when there is not enough of the Part on hand",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Part"
+                ],
+                "summary": "use a Part on a Service Order",
+                "operationId": "add-PartDetail",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id of the Service Order",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The Part used",
+                        "name": "Body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.addPartDetailRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.PartDetailResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/service-orders/{id}/parts/{part_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "remove a Part line from an open Service Order that was not invoiced, the Part goes back to stock",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Part"
+                ],
+                "summary": "remove a Part from a Service Order",
+                "operationId": "delete-PartDetail",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id of the Service Order",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The id of the Part",
+                        "name": "part_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/service-orders/{id}/services": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET the Service lines of a Service Order",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service"
+                ],
+                "summary": "list the Services of a Service Order",
+                "operationId": "list-ServiceDetail",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id of the Service Order",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.ServiceDetailResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "add a Service line to a Service Order, its price must be within the range of the Service unless an admin overrides it with a reason",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service"
+                ],
+                "summary": "add a Service to a Service Order",
+                "operationId": "add-ServiceDetail",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id of the Service Order",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The Service done",
+                        "name": "Body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.addServiceDetailRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.ServiceDetailResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/service-orders/{id}/services/{service_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "remove a Service line no mechanic worked on from an open Service Order that was not invoiced.\nThe order in progress moves to ready when the other lines are all done or declined.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service"
+                ],
+                "summary": "remove a Service from a Service Order",
+                "operationId": "delete-ServiceDetail",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id of the Service Order",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The id of the Service",
+                        "name": "service_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/service-orders/{id}/services/{service_id}/transitions": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "pending -\u003e started \u003c-\u003e paused, a started or paused line can be done and the customer can decline a pending or paused line.\nThe Service Order moves from in_progress to ready when all its lines are done or declined.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service"
+                ],
+                "summary": "move a Service line to another state",
+                "operationId": "transition-ServiceDetail",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id of the Service Order",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The id of the Service",
+                        "name": "service_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The state to move to",
+                        "name": "Body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.transitionServiceDetailRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.TransitionServiceDetailResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "The version of the Service Order, to send in If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/service-orders/{id}/transitions": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "open -\u003e diagnosis -\u003e (awaiting_parts \u003c-\u003e) in_progress -\u003e ready -\u003e returned, any unfinished order can be cancelled.\nAn invoiced order can only be returned. The return date is set when the order is returned.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ServiceOrder"
+                ],
+                "summary": "move a Service Order to another state",
+                "operationId": "transition-ServiceOrder",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id of the Service Order",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The state to move to",
+                        "name": "Body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.transitionServiceOrderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.ServiceOrderResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "The version of the Service Order, to send in If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "open -\u003e diagnosis -\u003e (awaiting_parts \u003c-\u003e) in_progress -\u003e ready -\u003e returned, any unfinished order can be cancelled.\nAn invoiced order can only be returned. The return date is set when the order is returned.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ServiceOrder"
+                ],
+                "summary": "move a Service Order to another state",
+                "operationId": "transition-ServiceOrder",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id of the Service Order",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The state to move to",
+                        "name": "Body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.transitionServiceOrderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.ServiceOrderResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "The version of the Service Order, to send in If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/services": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET list of all Services of the catalog",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service"
+                ],
+                "summary": "list all Services",
+                "operationId": "list-Service",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The next_cursor of the previous page, none for the first page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of Services per page, 20 by default",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/api.PageResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/api.ServiceResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new Service of the catalog",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service"
+                ],
+                "summary": "Create new Service",
+                "operationId": "create-Service",
+                "parameters": [
+                    {
+                        "description": "The body to create a Service",
+                        "name": "Body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.createServiceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.ServiceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/services/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET  Service of the catalog by it's id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service"
+                ],
+                "summary": "GET Service",
+                "operationId": "get-Service",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id to get a Service",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.ServiceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "update a Service of the catalog, the lines already on Service Orders keep their price",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service"
+                ],
+                "summary": "update  Service",
+                "operationId": "update-Service",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id to update a Service",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The body to update a Service",
+                        "name": "Body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.updateServiceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.ServiceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "delete a Service of the catalog, refused while Service Orders use it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service"
+                ],
+                "summary": "DELETE a Service",
+                "operationId": "delete-Service",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id to delete a Service",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/suppliers": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET list of all Suppliers",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Supplier"
+                ],
+                "summary": "list all Suppliers",
+                "operationId": "list-Supplier",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The next_cursor of the previous page, none for the first page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of Suppliers per page, 20 by default",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/api.PageResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/api.SupplierResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new Supplier",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Supplier"
+                ],
+                "summary": "Create new Supplier",
+                "operationId": "create-Supplier",
+                "parameters": [
+                    {
+                        "description": "The body to create a Supplier",
+                        "name": "Body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.createSupplierRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.SupplierResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/suppliers/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET  Supplier by it's id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Supplier"
+                ],
+                "summary": "GET Supplier",
+                "operationId": "get-Supplier",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id to get a Supplier",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.SupplierResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "update a  Supplier",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Supplier"
+                ],
+                "summary": "update  Supplier",
+                "operationId": "update-Supplier",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id to update a Supplier",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The body to update a Supplier",
+                        "name": "Body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.updateSupplierRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.SupplierResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "use this api to delete a supplier by it's id, a supplier with purchase invoices cannot be deleted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Supplier"
+                ],
+                "summary": "DELETE a Supplier",
+                "operationId": "delete-Supplier",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The id to delete a Supplier",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tokens/renew_access": {
+            "post": {
+                "description": "Issue a new access token from the refresh token of a session that is not logged out or expired",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "renew an access token",
+                "operationId": "renew-access-token",
+                "parameters": [
+                    {
+                        "description": "The refresh token",
+                        "name": "Body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.renewAccessTokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.RenewAccessTokenResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GET list of all staff Users, only admins can list Users",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "list all Users",
+                "operationId": "list-User",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The next_cursor of the previous page, none for the first page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The number of Users per page, 20 by default",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/api.PageResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/api.UserResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new staff User, only admins can create Users",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Create new User",
+                "operationId": "create-User",
+                "parameters": [
+                    {
+                        "description": "The body to create a User",
+                        "name": "Body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.createUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/login": {
+            "post": {
+                "description": "Check the password of a User, open a session and issue an access and a refresh token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "log a User in",
+                "operationId": "login-User",
+                "parameters": [
+                    {
+                        "description": "The credentials of a User",
+                        "name": "Body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.loginUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.LoginUserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/logout": {
+            "post": {
+                "description": "Block the session of a refresh token so it can no longer renew access tokens, the access tokens already issued stay valid until they expire",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "log a User out",
+                "operationId": "logout-User",
+                "parameters": [
+                    {
+                        "description": "The refresh token of the session",
+                        "name": "Body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.logoutUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/setup": {
+            "post": {
+                "description": "Create the first admin User of a new installation, refused once any User exists",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Create the first admin",
+                "operationId": "setup-User",
+                "parameters": [
+                    {
+                        "description": "The first admin",
+                        "name": "Body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.setupUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
     },
     "definitions": {
-        "CustomersResponse": {
+        "api.AppointmentResponse": {
+            "type": "object",
+            "properties": {
+                "bay": {
+                    "description": "The bay booked\nexample: 1",
+                    "type": "integer"
+                },
+                "car_id": {
+                    "description": "The ID of the Car to service\nexample: 1",
+                    "type": "integer"
+                },
+                "customer_id": {
+                    "description": "The ID of the Customer who booked\nexample: 1",
+                    "type": "integer"
+                },
+                "ends_at": {
+                    "description": "When the Services requested should be done, from their estimation times\nexample: 2022-06-01T10:30:00Z",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "The ID of the Appointment\nexample: 1",
+                    "type": "integer"
+                },
+                "notes": {
+                    "description": "What the customer said on the phone\nexample: brakes are noisy",
+                    "type": "string"
+                },
+                "service_order_id": {
+                    "description": "The ID of the Service Order opened when the Car arrived\nexample: 1",
+                    "type": "integer"
+                },
+                "services": {
+                    "description": "The Services requested",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.ServiceResponse"
+                    }
+                },
+                "starts_at": {
+                    "description": "When the Car is expected\nexample: 2022-06-01T09:00:00Z",
+                    "type": "string"
+                },
+                "state": {
+                    "description": "The state of the Appointment\nexample: booked",
+                    "type": "string"
+                }
+            }
+        },
+        "api.ArriveAppointmentResponse": {
+            "type": "object",
+            "properties": {
+                "appointment": {
+                    "description": "The Appointment, arrived",
+                    "$ref": "#/definitions/api.AppointmentResponse"
+                },
+                "service_details": {
+                    "description": "The lines of the Service Order, one per Service requested at the min price of the catalog",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.ServiceDetailResponse"
+                    }
+                },
+                "service_order": {
+                    "description": "The Service Order opened for the Car",
+                    "$ref": "#/definitions/api.ServiceOrderResponse"
+                }
+            }
+        },
+        "api.BayScheduleResponse": {
+            "type": "object",
+            "properties": {
+                "appointments": {
+                    "description": "The Appointments of the bay during the day, in the order of their start",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.AppointmentResponse"
+                    }
+                },
+                "bay": {
+                    "description": "The bay\nexample: 1",
+                    "type": "integer"
+                }
+            }
+        },
+        "api.CarResponse": {
+            "type": "object",
+            "properties": {
+                "customer_id": {
+                    "description": "The ID of the Customer owning the Car\nexample: 1",
+                    "type": "integer"
+                },
+                "deleted_at": {
+                    "description": "The time a Car was deleted, empty unless it is deleted\nexample: 2022-06-03T10:15:00Z",
+                    "type": "string"
+                },
+                "energy": {
+                    "description": "The Energy of a Car\nexample: diesel",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "The ID of a Car\nexample: 1",
+                    "type": "integer"
+                },
+                "make": {
+                    "description": "The Make of a Car\nexample: Renault",
+                    "type": "string"
+                },
+                "model": {
+                    "description": "The Model of a Car\nexample: Clio",
+                    "type": "string"
+                },
+                "registraion_number": {
+                    "description": "The registration number of a Car\nexample: 12345-116-16",
+                    "type": "string"
+                },
+                "version": {
+                    "description": "The version of a Car, bumped by every update\nexample: 1",
+                    "type": "integer"
+                },
+                "year": {
+                    "description": "The Year of a Car\nexample: 2016",
+                    "type": "string"
+                }
+            }
+        },
+        "api.CustomerResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "The time a Customer was created\nexample: 2021-05-25T00:53:16.535668Z",
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "description": "The time a Customer was deleted, empty unless it is deleted\nexample: 2022-06-03T10:15:00Z",
+                    "type": "string"
+                },
+                "full_name": {
+                    "description": "The Name of a Customer\nexample: Karim Stam",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "The ID of a Customer\nexample: 1 2 3 4 5",
+                    "type": "integer"
+                },
+                "phone_number": {
+                    "description": "The PhoneNumber of a Customer\nexample: +2131122334455",
+                    "type": "string"
+                },
+                "version": {
+                    "description": "The version of a Customer, bumped by every update\nexample: 1",
+                    "type": "integer"
+                }
+            }
+        },
+        "api.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "A machine-readable code of the error\nexample: not_found",
+                    "type": "string"
+                },
+                "error": {
+                    "description": "A description of the error\nexample: sql: no rows in result set",
+                    "type": "string"
+                }
+            }
+        },
+        "api.InvoiceRefResponse": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "description": "The day the invoice was issued\nexample: 2022-06-03T00:00:00Z",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "The ID of the Sale Invoice\nexample: 1",
+                    "type": "integer"
+                },
+                "ref": {
+                    "description": "The sequential reference of the invoice\nexample: INV-000042",
+                    "type": "string"
+                }
+            }
+        },
+        "api.LaborEntryResponse": {
+            "type": "object",
+            "properties": {
+                "clocked_in_at": {
+                    "description": "When the Mechanic clocked in\nexample: 2022-06-01T09:30:00Z",
+                    "type": "string"
+                },
+                "clocked_out_at": {
+                    "description": "When the Mechanic clocked out, empty while clocked in\nexample: 2022-06-01T10:15:00Z",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "The ID of the entry\nexample: 1",
+                    "type": "integer"
+                },
+                "mechanic_id": {
+                    "description": "The ID of the Mechanic who worked\nexample: 1",
+                    "type": "integer"
+                },
+                "service_detail_id": {
+                    "description": "The ID of the Service line worked on\nexample: 1",
+                    "type": "integer"
+                }
+            }
+        },
+        "api.LoginUserResponse": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "description": "The token to send in the Authorization header as \"Bearer \u003ctoken\u003e\"",
+                    "type": "string"
+                },
+                "access_token_expires_at": {
+                    "description": "The time the access token expires\nexample: 2022-06-03T10:15:00Z",
+                    "type": "string"
+                },
+                "refresh_token": {
+                    "description": "The token to renew the access token with, until the session is logged out",
+                    "type": "string"
+                },
+                "refresh_token_expires_at": {
+                    "description": "The time the refresh token expires\nexample: 2022-06-04T10:00:00Z",
+                    "type": "string"
+                },
+                "session_id": {
+                    "description": "The session opened by the login\nexample: 7c9e6679-7425-40de-944b-e07fc1f90ae7",
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "user": {
+                    "description": "The User logged in",
+                    "$ref": "#/definitions/api.UserResponse"
+                }
+            }
+        },
+        "api.OutstandingInvoicesResponse": {
+            "type": "object",
+            "properties": {
+                "buckets": {
+                    "description": "The number of invoices and amount outstanding per age of the invoices, in days",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/db.ListOutstandingInvoiceAgingRow"
+                    }
+                },
+                "invoices": {
+                    "description": "The invoices not fully paid, oldest first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.SaleInvoiceBalanceResponse"
+                    }
+                }
+            }
+        },
+        "api.PageResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "description": "The items of the page"
+                },
+                "next_cursor": {
+                    "description": "The cursor to send to get the next page, empty on the last page\nexample: eyJhZnRlcl9pZCI6MjB9",
+                    "type": "string"
+                },
+                "total_count": {
+                    "description": "The number of items of all the pages\nexample: 42",
+                    "type": "integer"
+                }
+            }
+        },
+        "api.PartDetailResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "The ID of the line\nexample: 1",
+                    "type": "integer"
+                },
+                "part_id": {
+                    "description": "The ID of the Part used\nexample: 1",
+                    "type": "integer"
+                },
+                "price": {
+                    "description": "The unit price charged\nexample: 1200.00",
+                    "type": "string"
+                },
+                "quantity": {
+                    "description": "The quantity used\nexample: 2",
+                    "type": "integer"
+                },
+                "service_order_id": {
+                    "description": "The ID of the Service Order\nexample: 1",
+                    "type": "integer"
+                }
+            }
+        },
+        "api.PartHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "The Name of the Part\nexample: oil filter",
+                    "type": "string"
+                },
+                "part_id": {
+                    "description": "The ID of the Part used\nexample: 1",
+                    "type": "integer"
+                },
+                "price": {
+                    "description": "The price charged for one\nexample: 1200.00",
+                    "type": "string"
+                },
+                "quantity": {
+                    "description": "The quantity used\nexample: 1",
+                    "type": "integer"
+                }
+            }
+        },
+        "api.PurchaseInvoiceResponse": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "description": "The date of the invoice\nexample: 2022-06-01T00:00:00Z",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "The ID of a Purchase Invoice\nexample: 1",
+                    "type": "integer"
+                },
+                "lines": {
+                    "description": "The lines of the invoice",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/db.PurchaseDetail"
+                    }
+                },
+                "ref": {
+                    "description": "The reference of the invoice given by the Supplier\nexample: FA-2022-0042",
+                    "type": "string"
+                },
+                "supplier_id": {
+                    "description": "The ID of the Supplier\nexample: 1",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "The sum of the lines of the invoice\nexample: 24000.00",
+                    "type": "string"
+                }
+            }
+        },
+        "api.RenewAccessTokenResponse": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "description": "The token to send in the Authorization header as \"Bearer \u003ctoken\u003e\"",
+                    "type": "string"
+                },
+                "access_token_expires_at": {
+                    "description": "The time the access token expires\nexample: 2022-06-03T10:15:00Z",
+                    "type": "string"
+                }
+            }
+        },
+        "api.SaleInvoiceBalanceResponse": {
+            "type": "object",
+            "properties": {
+                "age_days": {
+                    "description": "The number of days since the invoice was issued\nexample: 12",
+                    "type": "integer"
+                },
+                "aging_bucket": {
+                    "description": "The aging bucket of the invoice: 0-30, 31-60 or 60+\nexample: 0-30",
+                    "type": "string"
+                },
+                "date": {
+                    "description": "The day the invoice was issued\nexample: 2022-06-03T00:00:00Z",
+                    "type": "string"
+                },
+                "outstanding": {
+                    "description": "The amount left to pay, negative when the invoice is overpaid\nexample: 9500.00",
+                    "type": "string"
+                },
+                "paid": {
+                    "description": "The sum of the payments of the invoice\nexample: 5000.00",
+                    "type": "string"
+                },
+                "ref": {
+                    "description": "The sequential reference of the invoice\nexample: INV-000042",
+                    "type": "string"
+                },
+                "sale_invoice_id": {
+                    "description": "The ID of the Sale Invoice\nexample: 1",
+                    "type": "integer"
+                },
+                "service_order_id": {
+                    "description": "The ID of the Service Order invoiced\nexample: 1",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "Whether the invoice is unpaid, partial, paid or overpaid\nexample: partial",
+                    "type": "string"
+                },
+                "total": {
+                    "description": "The total of the invoice\nexample: 14500.00",
+                    "type": "string"
+                }
+            }
+        },
+        "api.SaleInvoiceResponse": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "description": "The day the invoice was issued\nexample: 2022-06-03T00:00:00Z",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "The ID of a Sale Invoice\nexample: 1",
+                    "type": "integer"
+                },
+                "lines": {
+                    "description": "The services and parts invoiced, with the prices at the time the invoice was issued",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/db.SaleInvoiceLine"
+                    }
+                },
+                "ref": {
+                    "description": "The sequential reference of the invoice\nexample: INV-000042",
+                    "type": "string"
+                },
+                "service_order_id": {
+                    "description": "The ID of the Service Order invoiced\nexample: 1",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "The sum of the lines of the invoice\nexample: 14500.00",
+                    "type": "string"
+                }
+            }
+        },
+        "api.ScheduleResponse": {
+            "type": "object",
+            "properties": {
+                "bays": {
+                    "description": "Every bay of the workshop, with its Appointments",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.BayScheduleResponse"
+                    }
+                },
+                "date": {
+                    "description": "The day planned\nexample: 2022-06-01",
+                    "type": "string"
+                }
+            }
+        },
+        "api.ServiceDetailResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "The ID of the line\nexample: 1",
+                    "type": "integer"
+                },
+                "price": {
+                    "description": "The price charged\nexample: 2500.00",
+                    "type": "string"
+                },
+                "price_overridden_by": {
+                    "description": "The admin who allowed the price outside the range of the Service\nexample: admin",
+                    "type": "string"
+                },
+                "price_override_reason": {
+                    "description": "Why the price is outside the range of the Service, empty when it is not\nexample: loyal customer discount",
+                    "type": "string"
+                },
+                "service_id": {
+                    "description": "The ID of the Service done\nexample: 1",
+                    "type": "integer"
+                },
+                "service_order_id": {
+                    "description": "The ID of the Service Order\nexample: 1",
+                    "type": "integer"
+                },
+                "started_at": {
+                    "description": "When the work on the line first started\nexample: 2022-06-01T09:30:00Z",
+                    "type": "string"
+                },
+                "state": {
+                    "description": "The state of the work on the line\nexample: started",
+                    "type": "string"
+                },
+                "stopped_at": {
+                    "description": "When the work on the line last stopped, empty while it is started\nexample: 2022-06-01T10:15:00Z",
+                    "type": "string"
+                }
+            }
+        },
+        "api.ServiceHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "The Name of the Service\nexample: oil change",
+                    "type": "string"
+                },
+                "price": {
+                    "description": "The price charged\nexample: 2500.00",
+                    "type": "string"
+                },
+                "service_id": {
+                    "description": "The ID of the Service done\nexample: 1",
+                    "type": "integer"
+                },
+                "state": {
+                    "description": "The state of the work on the line\nexample: done",
+                    "type": "string"
+                }
+            }
+        },
+        "api.ServiceLaborReportResponse": {
+            "type": "object",
+            "properties": {
+                "actual_hours": {
+                    "description": "The hours the Mechanics clocked on the lines\nexample: 11.5",
+                    "type": "number"
+                },
+                "estimated_hours": {
+                    "description": "The hours estimated for the lines, from the estimation time of the Service\nexample: 9",
+                    "type": "number"
+                },
+                "lines": {
+                    "description": "The number of lines of the Service done in the period\nexample: 12",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "The Name of the Service\nexample: oil change",
+                    "type": "string"
+                },
+                "overrun_hours": {
+                    "description": "The hours worked beyond the estimate, negative when the lines took less\nexample: 2.5",
+                    "type": "number"
+                },
+                "service_id": {
+                    "description": "The ID of the Service\nexample: 1",
+                    "type": "integer"
+                }
+            }
+        },
+        "api.ServiceOrderHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "invoice": {
+                    "description": "The invoice of the Service Order, empty until it is invoiced",
+                    "$ref": "#/definitions/api.InvoiceRefResponse"
+                },
+                "mechanics": {
+                    "description": "The Mechanics who worked on the Car",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/db.Mechanic"
+                    }
+                },
+                "parts": {
+                    "description": "The Parts used",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.PartHistoryResponse"
+                    }
+                },
+                "service_order": {
+                    "description": "The Service Order",
+                    "$ref": "#/definitions/api.ServiceOrderResponse"
+                },
+                "services": {
+                    "description": "The Services done",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.ServiceHistoryResponse"
+                    }
+                }
+            }
+        },
+        "api.ServiceOrderResponse": {
+            "type": "object",
+            "properties": {
+                "car_id": {
+                    "description": "The ID of the Car being serviced\nexample: 1",
+                    "type": "integer"
+                },
+                "date_received": {
+                    "description": "The day the Car was received\nexample: 2022-06-01T00:00:00Z",
+                    "type": "string"
+                },
+                "date_returned": {
+                    "description": "The day the Car was returned to the Customer\nexample: 2022-06-03T00:00:00Z",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "What the customer asked for\nexample: brakes are noisy",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "The ID of a Service Order\nexample: 1",
+                    "type": "integer"
+                },
+                "mileage": {
+                    "description": "The odometer reading of the Car when it was received, in kilometers\nexample: 84500",
+                    "type": "integer"
+                },
+                "state": {
+                    "description": "The state of the Service Order\nexample: in_progress",
+                    "type": "string"
+                },
+                "version": {
+                    "description": "The version of the Service Order, bumped by every update\nexample: 1",
+                    "type": "integer"
+                }
+            }
+        },
+        "api.ServiceResponse": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "description": "The Description of a Service\nexample: drain the engine oil and replace the oil filter",
+                    "type": "string"
+                },
+                "estimation_time": {
+                    "description": "The time the Service takes, in minutes\nexample: 45",
+                    "type": "integer"
+                },
+                "id": {
+                    "description": "The ID of a Service\nexample: 1",
+                    "type": "integer"
+                },
+                "max_price": {
+                    "description": "The highest price the Service is charged at\nexample: 4000.00",
+                    "type": "string"
+                },
+                "min_price": {
+                    "description": "The lowest price the Service is charged at\nexample: 2500.00",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "The Name of a Service\nexample: oil change",
+                    "type": "string"
+                }
+            }
+        },
+        "api.SupplierResponse": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "description": "The Address of a Supplier\nexample: 12 rue Didouche Mourad, Algiers",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "The ID of a Supplier\nexample: 1",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "The Name of a Supplier\nexample: Auto Parts Algiers",
+                    "type": "string"
+                },
+                "phone_number": {
+                    "description": "The PhoneNumber of a Supplier\nexample: +2131122334455",
+                    "type": "string"
+                }
+            }
+        },
+        "api.TransitionServiceDetailResponse": {
+            "type": "object",
+            "properties": {
+                "service_detail": {
+                    "description": "The Service line after the transition",
+                    "$ref": "#/definitions/api.ServiceDetailResponse"
+                },
+                "service_order": {
+                    "description": "The Service Order of the line, ready when its last line was finished",
+                    "$ref": "#/definitions/api.ServiceOrderResponse"
+                }
+            }
+        },
+        "api.UserResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "The time a User was created\nexample: 2022-06-01T08:00:00Z",
+                    "type": "string"
+                },
+                "full_name": {
+                    "description": "The Name of a User\nexample: Karim Stam",
+                    "type": "string"
+                },
+                "password_changed_at": {
+                    "description": "The last time the password of a User was changed\nexample: 2022-06-03T10:00:00Z",
+                    "type": "string"
+                },
+                "role": {
+                    "description": "The role of a User: admin, front_desk, mechanic or accountant\nexample: front_desk",
+                    "type": "string"
+                },
+                "username": {
+                    "description": "The login of a User\nexample: karim",
+                    "type": "string"
+                }
+            }
+        },
+        "api.addPartDetailRequest": {
+            "type": "object",
+            "required": [
+                "partId",
+                "quantity"
+            ],
+            "properties": {
+                "partId": {
+                    "description": "The ID of the Part used\nexample: 1",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "price": {
+                    "description": "The unit price charged, defaults to the retail price of the Part\nexample: 1200.00",
+                    "type": "string"
+                },
+                "quantity": {
+                    "description": "The quantity used\nexample: 2",
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "api.addServiceDetailRequest": {
+            "type": "object",
+            "required": [
+                "serviceId"
+            ],
+            "properties": {
+                "overridePrice": {
+                    "description": "Charge a price outside the range of the Service, for admins only\nexample: false",
+                    "type": "boolean"
+                },
+                "overrideReason": {
+                    "description": "Why the price is outside the range of the Service, required with OverridePrice\nexample: loyal customer discount",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "price": {
+                    "description": "The price charged, defaults to the min price of the Service\nexample: 2500.00",
+                    "type": "string"
+                },
+                "serviceId": {
+                    "description": "The ID of the Service done\nexample: 1",
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "api.arriveAppointmentRequest": {
+            "type": "object",
+            "properties": {
+                "mileage": {
+                    "description": "The odometer reading of the Car, in kilometers\nexample: 84500",
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "api.assignMechanicRequest": {
+            "type": "object",
+            "required": [
+                "mechanicId"
+            ],
+            "properties": {
+                "mechanicId": {
+                    "description": "The ID of the Mechanic to assign\nexample: 1",
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "api.clockInRequest": {
+            "type": "object",
+            "required": [
+                "serviceId",
+                "serviceOrderId"
+            ],
+            "properties": {
+                "serviceId": {
+                    "description": "The ID of the Service of the line worked on\nexample: 1",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "serviceOrderId": {
+                    "description": "The ID of the Service Order worked on\nexample: 1",
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "api.createAppointmentRequest": {
+            "type": "object",
+            "required": [
+                "carId",
+                "customerId",
+                "serviceIds",
+                "startsAt"
+            ],
+            "properties": {
+                "bay": {
+                    "description": "The bay to book, the first free bay when empty\nexample: 1",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "carId": {
+                    "description": "The ID of the Car to service, a Car of the Customer\nexample: 1",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "customerId": {
+                    "description": "The ID of the Customer who books\nexample: 1",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "notes": {
+                    "description": "What the customer said on the phone\nexample: brakes are noisy",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "serviceIds": {
+                    "description": "The IDs of the Services requested, they set how long the bay is booked\nexample: [1,2]",
+                    "type": "array",
+                    "minItems": 1,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "startsAt": {
+                    "description": "When the Car is expected\nexample: 2022-06-01T09:00:00Z",
+                    "type": "string"
+                }
+            }
+        },
+        "api.createCarRequest": {
+            "type": "object",
+            "required": [
+                "customerId",
+                "energy",
+                "make",
+                "model",
+                "registrationNumber",
+                "year"
+            ],
+            "properties": {
+                "customerId": {
+                    "description": "The ID of the Customer owning the Car\nexample: 1",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "energy": {
+                    "description": "The Energy of a Car\nexample: diesel",
+                    "type": "string"
+                },
+                "make": {
+                    "description": "The Make of a Car\nexample: Renault",
+                    "type": "string"
+                },
+                "model": {
+                    "description": "The Model of a Car\nexample: Clio",
+                    "type": "string"
+                },
+                "registrationNumber": {
+                    "description": "The registration number of a Car\nexample: 12345-116-16",
+                    "type": "string"
+                },
+                "year": {
+                    "description": "The Year of a Car\nexample: 2016",
+                    "type": "string"
+                }
+            }
+        },
+        "api.createCustomerRequest": {
+            "type": "object",
+            "required": [
+                "fullName",
+                "phoneNumber"
+            ],
+            "properties": {
+                "fullName": {
+                    "description": "The Name of a Customer\nexample: Karim Stam",
+                    "type": "string"
+                },
+                "phoneNumber": {
+                    "description": "The PhoneNumber for a Customer, normalized to E.164\nexample: +213550123456",
+                    "type": "string"
+                }
+            }
+        },
+        "api.createMechanicRequest": {
+            "type": "object",
+            "required": [
+                "fullName"
+            ],
+            "properties": {
+                "fullName": {
+                    "description": "The Name of a Mechanic\nexample: Karim Stam",
+                    "type": "string"
+                }
+            }
+        },
+        "api.createPartRequest": {
+            "type": "object",
+            "required": [
+                "description",
+                "name",
+                "retailPrice"
+            ],
+            "properties": {
+                "description": {
+                    "description": "The Description of a Part\nexample: oil filter for Renault Clio 4",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "The Name of a Part\nexample: oil filter",
+                    "type": "string"
+                },
+                "reorderLevel": {
+                    "description": "The stock on hand at or below which the Part is reported as low on stock\nexample: 5",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "retailPrice": {
+                    "description": "The price the Part is sold at\nexample: 1200.00",
+                    "type": "string"
+                }
+            }
+        },
+        "api.createPaymentRequest": {
+            "type": "object",
+            "required": [
+                "amount"
+            ],
+            "properties": {
+                "amount": {
+                    "description": "The amount paid\nexample: 5000.00",
+                    "type": "string"
+                },
+                "date": {
+                    "description": "The day of the payment, defaults to today\nexample: 2022-06-03",
+                    "type": "string"
+                }
+            }
+        },
+        "api.createPurchaseInvoiceRequest": {
+            "type": "object",
+            "required": [
+                "ref",
+                "supplierId"
+            ],
+            "properties": {
+                "date": {
+                    "description": "The date of the invoice\nexample: 2022-06-01",
+                    "type": "string"
+                },
+                "lines": {
+                    "description": "The lines of the invoice",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.purchaseDetailRequest"
+                    }
+                },
+                "ref": {
+                    "description": "The reference of the invoice given by the Supplier\nexample: FA-2022-0042",
+                    "type": "string"
+                },
+                "supplierId": {
+                    "description": "The ID of the Supplier\nexample: 1",
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "api.createServiceOrderRequest": {
+            "type": "object",
+            "required": [
+                "carId"
+            ],
+            "properties": {
+                "carId": {
+                    "description": "The ID of the Car being serviced\nexample: 1",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "description": {
+                    "description": "What the customer asked for\nexample: brakes are noisy",
+                    "type": "string"
+                },
+                "mileage": {
+                    "description": "The odometer reading of the Car, in kilometers\nexample: 84500",
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "api.createServiceRequest": {
+            "type": "object",
+            "required": [
+                "description",
+                "estimationTime",
+                "maxPrice",
+                "minPrice",
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "description": "The Description of a Service\nexample: drain the engine oil and replace the oil filter",
+                    "type": "string"
+                },
+                "estimationTime": {
+                    "description": "The time the Service takes, in minutes\nexample: 45",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "maxPrice": {
+                    "description": "The highest price the Service is charged at, at least the MinPrice\nexample: 4000.00",
+                    "type": "string"
+                },
+                "minPrice": {
+                    "description": "The lowest price the Service is charged at, the price of a service line by default\nexample: 2500.00",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "The Name of a Service\nexample: oil change",
+                    "type": "string"
+                }
+            }
+        },
+        "api.createSupplierRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "phoneNumber"
+            ],
+            "properties": {
+                "address": {
+                    "description": "The Address of a Supplier\nexample: 12 rue Didouche Mourad, Algiers",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "The Name of a Supplier\nexample: Auto Parts Algiers",
+                    "type": "string"
+                },
+                "phoneNumber": {
+                    "description": "The PhoneNumber of a Supplier, normalized to E.164\nexample: +213550123456",
+                    "type": "string"
+                }
+            }
+        },
+        "api.createUserRequest": {
+            "type": "object",
+            "required": [
+                "fullName",
+                "password",
+                "role",
+                "username"
+            ],
+            "properties": {
+                "fullName": {
+                    "description": "The Name of a User\nexample: Karim Stam",
+                    "type": "string"
+                },
+                "password": {
+                    "description": "The password of a User\nexample: secret123",
+                    "type": "string",
+                    "minLength": 6
+                },
+                "role": {
+                    "description": "The role of a User: admin, front_desk, mechanic or accountant\nexample: front_desk",
+                    "type": "string",
+                    "enum": [
+                        "admin",
+                        "front_desk",
+                        "mechanic",
+                        "accountant"
+                    ]
+                },
+                "username": {
+                    "description": "The login of a User\nexample: karim",
+                    "type": "string"
+                }
+            }
+        },
+        "api.loginUserRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "username"
+            ],
+            "properties": {
+                "password": {
+                    "description": "The password of a User\nexample: secret123",
+                    "type": "string",
+                    "minLength": 6
+                },
+                "username": {
+                    "description": "The login of a User\nexample: karim",
+                    "type": "string"
+                }
+            }
+        },
+        "api.logoutUserRequest": {
+            "type": "object",
+            "required": [
+                "refreshToken"
+            ],
+            "properties": {
+                "refreshToken": {
+                    "description": "The refresh token of the session to close",
+                    "type": "string"
+                }
+            }
+        },
+        "api.patchCustomerRequest": {
+            "type": "object",
+            "properties": {
+                "fullName": {
+                    "description": "The new Name of the Customer, unchanged when omitted\nexample: Karim Stam",
+                    "type": "string",
+                    "minLength": 1
+                },
+                "phoneNumber": {
+                    "description": "The new PhoneNumber of the Customer, normalized to E.164, unchanged when omitted\nexample: +213550123456",
+                    "type": "string"
+                }
+            }
+        },
+        "api.patchServiceOrderRequest": {
             "type": "object",
             "properties": {
-                "customers": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/api.CustomerResponse"
-                    }
+                "description": {
+                    "description": "What the customer asked for, unchanged when omitted\nexample: brakes are noisy",
+                    "type": "string",
+                    "minLength": 1
+                },
+                "mileage": {
+                    "description": "The odometer reading of the Car, in kilometers, unchanged when omitted\nexample: 84500",
+                    "type": "integer",
+                    "minimum": 0
                 }
             }
         },
-        "api.CustomerResponse": {
+        "api.purchaseDetailRequest": {
             "type": "object",
+            "required": [
+                "partId",
+                "price",
+                "quantity"
+            ],
             "properties": {
-                "created_at": {
-                    "description": "The time a Customer was created\nexample: 2021-05-25T00:53:16.535668Z",
+                "partId": {
+                    "description": "The ID of the Part purchased\nexample: 1",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "price": {
+                    "description": "The unit price paid\nexample: 1200.00",
                     "type": "string"
                 },
-                "full_name": {
-                    "description": "The Name of a Customer\nexample: Karim Stam",
+                "quantity": {
+                    "description": "The quantity purchased\nexample: 20",
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "api.renewAccessTokenRequest": {
+            "type": "object",
+            "required": [
+                "refreshToken"
+            ],
+            "properties": {
+                "refreshToken": {
+                    "description": "The refresh token issued at login",
+                    "type": "string"
+                }
+            }
+        },
+        "api.setupUserRequest": {
+            "type": "object",
+            "required": [
+                "fullName",
+                "password",
+                "username"
+            ],
+            "properties": {
+                "fullName": {
+                    "description": "The Name of the first admin\nexample: Karim Stam",
                     "type": "string"
                 },
-                "id": {
-                    "description": "The ID of a Customer\nexample: 1 2 3 4 5",
-                    "type": "integer"
+                "password": {
+                    "description": "The password of the first admin\nexample: secret123",
+                    "type": "string",
+                    "minLength": 6
                 },
-                "phone_number": {
-                    "description": "The PhoneNumber of a Customer\nexample: +2131122334455",
+                "username": {
+                    "description": "The login of the first admin\nexample: admin",
                     "type": "string"
                 }
             }
         },
-        "api.ErrorResponse": {
+        "api.transitionServiceDetailRequest": {
             "type": "object",
+            "required": [
+                "state"
+            ],
             "properties": {
-                "error": {
-                    "type": "string"
+                "state": {
+                    "description": "The state to move the Service line to\nexample: started",
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "started",
+                        "paused",
+                        "done",
+                        "declined"
+                    ]
+                }
+            }
+        },
+        "api.transitionServiceOrderRequest": {
+            "type": "object",
+            "required": [
+                "state"
+            ],
+            "properties": {
+                "state": {
+                    "description": "The state to move the Service Order to\nexample: diagnosis",
+                    "type": "string",
+                    "enum": [
+                        "open",
+                        "diagnosis",
+                        "awaiting_parts",
+                        "in_progress",
+                        "ready",
+                        "returned",
+                        "cancelled"
+                    ]
                 }
             }
         },
-        "api.ListCustomersRequest": {
+        "api.updateCarRequest": {
             "type": "object",
             "required": [
-                "pageID",
-                "pageSize"
+                "customerId",
+                "energy",
+                "make",
+                "model",
+                "registrationNumber",
+                "year"
             ],
             "properties": {
-                "pageID": {
+                "customerId": {
+                    "description": "The ID of the Customer owning the Car\nexample: 1",
                     "type": "integer",
                     "minimum": 1
                 },
-                "pageSize": {
-                    "type": "integer",
-                    "maximum": 10,
-                    "minimum": 5
+                "energy": {
+                    "description": "The Energy of a Car\nexample: diesel",
+                    "type": "string"
+                },
+                "make": {
+                    "description": "The Make of a Car\nexample: Renault",
+                    "type": "string"
+                },
+                "model": {
+                    "description": "The Model of a Car\nexample: Clio",
+                    "type": "string"
+                },
+                "registrationNumber": {
+                    "description": "The registration number of a Car\nexample: 12345-116-16",
+                    "type": "string"
+                },
+                "year": {
+                    "description": "The Year of a Car\nexample: 2016",
+                    "type": "string"
                 }
             }
         },
-        "api.createCustomerRequest": {
+        "api.updateCustomerRequest": {
             "type": "object",
             "required": [
                 "fullName",
@@ -338,11 +7056,292 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "phoneNumber": {
-                    "description": "The PhoneNumber for a Customer\nexample: +2131122334455",
+                    "description": "The PhoneNumber for a Customer, normalized to E.164\nexample: +213550123456",
+                    "type": "string"
+                }
+            }
+        },
+        "api.updateMechanicRequest": {
+            "type": "object",
+            "required": [
+                "fullName"
+            ],
+            "properties": {
+                "fullName": {
+                    "description": "The Name of a Mechanic\nexample: Karim Stam",
+                    "type": "string"
+                }
+            }
+        },
+        "api.updatePartRequest": {
+            "type": "object",
+            "required": [
+                "description",
+                "name",
+                "retailPrice"
+            ],
+            "properties": {
+                "description": {
+                    "description": "The Description of a Part\nexample: oil filter for Renault Clio 4",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "The Name of a Part\nexample: oil filter",
+                    "type": "string"
+                },
+                "reorderLevel": {
+                    "description": "The stock on hand at or below which the Part is reported as low on stock\nexample: 5",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "retailPrice": {
+                    "description": "The price the Part is sold at\nexample: 1200.00",
+                    "type": "string"
+                }
+            }
+        },
+        "api.updatePurchaseInvoiceRequest": {
+            "type": "object",
+            "required": [
+                "ref",
+                "supplierId"
+            ],
+            "properties": {
+                "date": {
+                    "description": "The date of the invoice\nexample: 2022-06-01",
+                    "type": "string"
+                },
+                "ref": {
+                    "description": "The reference of the invoice given by the Supplier\nexample: FA-2022-0042",
+                    "type": "string"
+                },
+                "supplierId": {
+                    "description": "The ID of the Supplier\nexample: 1",
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "api.updateServiceOrderRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "description": "What the customer asked for\nexample: brakes are noisy",
+                    "type": "string"
+                },
+                "mileage": {
+                    "description": "The odometer reading of the Car, in kilometers, removed when omitted\nexample: 84500",
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "api.updateServiceRequest": {
+            "type": "object",
+            "required": [
+                "description",
+                "estimationTime",
+                "maxPrice",
+                "minPrice",
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "description": "The Description of a Service\nexample: drain the engine oil and replace the oil filter",
+                    "type": "string"
+                },
+                "estimationTime": {
+                    "description": "The time the Service takes, in minutes\nexample: 45",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "maxPrice": {
+                    "description": "The highest price the Service is charged at, at least the MinPrice\nexample: 4000.00",
+                    "type": "string"
+                },
+                "minPrice": {
+                    "description": "The lowest price the Service is charged at, the price of a service line by default\nexample: 2500.00",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "The Name of a Service\nexample: oil change",
+                    "type": "string"
+                }
+            }
+        },
+        "api.updateSupplierRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "phoneNumber"
+            ],
+            "properties": {
+                "address": {
+                    "description": "The Address of a Supplier\nexample: 12 rue Didouche Mourad, Algiers",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "The Name of a Supplier\nexample: Auto Parts Algiers",
+                    "type": "string"
+                },
+                "phoneNumber": {
+                    "description": "The PhoneNumber of a Supplier, normalized to E.164\nexample: +213550123456",
+                    "type": "string"
+                }
+            }
+        },
+        "db.ListOutstandingInvoiceAgingRow": {
+            "type": "object",
+            "properties": {
+                "aging_bucket": {
+                    "type": "string"
+                },
+                "invoices": {
+                    "type": "integer"
+                },
+                "outstanding": {
+                    "type": "string"
+                }
+            }
+        },
+        "db.Mechanic": {
+            "type": "object",
+            "properties": {
+                "full_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "db.MechanicDetail": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "mechanic_id": {
+                    "type": "integer"
+                },
+                "service_order_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "db.Part": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "reorder_level": {
+                    "type": "integer"
+                },
+                "retail_price": {
+                    "type": "string"
+                }
+            }
+        },
+        "db.PartStock": {
+            "type": "object",
+            "properties": {
+                "consumed": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "on_hand": {
+                    "type": "integer"
+                },
+                "part_id": {
+                    "type": "integer"
+                },
+                "purchased": {
+                    "type": "integer"
+                },
+                "reorder_level": {
+                    "type": "integer"
+                }
+            }
+        },
+        "db.Payment": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "sale_invoice_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "db.PurchaseDetail": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "part_id": {
+                    "type": "integer"
+                },
+                "price": {
+                    "type": "string"
+                },
+                "purchase_invoice_id": {
+                    "type": "integer"
+                },
+                "quantity": {
+                    "type": "integer"
+                }
+            }
+        },
+        "db.SaleInvoiceLine": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "sale_invoice_id": {
+                    "type": "integer"
+                },
+                "unit_price": {
                     "type": "string"
                 }
             }
         }
+    },
+    "securityDefinitions": {
+        "BearerAuth": {
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
+        }
     }
 }`
 
@@ -353,7 +7352,7 @@ var SwaggerInfo = &swag.Spec{
 	BasePath:         "",
 	Schemes:          []string{},
 	Title:            "",
-	Description:      "",
+	Description:      "Type \"Bearer\" followed by a space and the access token of POST /users/login.",
 	InfoInstanceName: "swagger",
 	SwaggerTemplate:  docTemplate,
 }
@@ -3,6 +3,7 @@ package main
 import (
 	"database/sql"
 	"log"
+	_ "time/tzdata" // the timezones of SHOP_TIMEZONE, the alpine image has none
 
 	docs "github.com/STAMBOULI-ABDELKARIM/car_repair_shop/docs"
 	_ "github.com/lib/pq"
@@ -13,6 +14,10 @@ import (
 	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/util"
 )
 
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description Type "Bearer" followed by a space and the access token of POST /users/login.
 func main() {
 	docs.SwaggerInfo.Title = "ABDELKARIM STAMBOULI - CAR REPAIR SHOP API"
 	docs.SwaggerInfo.Description = "MANAGE CUSTOMERS FOR A CAR REPAR SHOPAPI."
@@ -30,8 +35,12 @@ func main() {
 		log.Fatal("cannot connect to db:", err)
 	}
 
-	store := db.New(conn)
-	server := api.NewServer(store)
+	store := db.NewStore(conn)
+	server, err := api.NewServer(config, store)
+	if err != nil {
+		log.Fatal("cannot create server:", err)
+	}
+
 	err = server.Start(config.ServerAddress)
 
 	if err != nil {
@@ -0,0 +1,32 @@
+package token
+
+import (
+	"fmt"
+	"time"
+)
+
+// The types of token a Maker can be created for
+const (
+	TypeJWT    = "jwt"
+	TypePaseto = "paseto"
+)
+
+// Maker is an interface for managing tokens
+type Maker interface {
+	// CreateToken creates a new token of a kind for a specific username, role and duration
+	CreateToken(username string, role string, kind string, duration time.Duration) (string, *Payload, error)
+
+	// VerifyToken checks if the token is valid and of the kind expected or not
+	VerifyToken(token string, kind string) (*Payload, error)
+}
+
+// NewMaker creates the Maker of a token type, jwt or paseto
+func NewMaker(tokenType string, symmetricKey string) (Maker, error) {
+	switch tokenType {
+	case TypeJWT:
+		return NewJWTMaker(symmetricKey)
+	case TypePaseto:
+		return NewPasetoMaker(symmetricKey)
+	}
+	return nil, fmt.Errorf("unsupported token type %q: must be %s or %s", tokenType, TypeJWT, TypePaseto)
+}
@@ -0,0 +1,68 @@
+package token
+
+import (
+	"errors"
+	"time"
+
+	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/util"
+	"github.com/google/uuid"
+)
+
+// Different types of error returned by the VerifyToken function
+var (
+	ErrInvalidToken   = errors.New("token is invalid")
+	ErrExpiredToken   = errors.New("token has expired")
+	ErrWrongTokenKind = errors.New("token is of the wrong kind")
+)
+
+// The kinds of token: an access token authorizes requests, a refresh token only renews
+// access tokens for the session it was issued with
+const (
+	KindAccess  = "access"
+	KindRefresh = "refresh"
+)
+
+// Payload contains the payload data of the token
+type Payload struct {
+	ID        uuid.UUID `json:"id"`
+	Username  string    `json:"username"`
+	Role      string    `json:"role"`
+	Kind      string    `json:"kind"`
+	IssuedAt  time.Time `json:"issued_at"`
+	ExpiredAt time.Time `json:"expired_at"`
+}
+
+// NewPayload creates a new token payload with a specific username, role, kind and duration
+func NewPayload(username string, role string, kind string, duration time.Duration) *Payload {
+	return &Payload{
+		ID:        uuid.New(),
+		Username:  username,
+		Role:      role,
+		Kind:      kind,
+		IssuedAt:  time.Now(),
+		ExpiredAt: time.Now().Add(duration),
+	}
+}
+
+// Valid checks if the token payload is valid or not: it must name a user with a
+// supported role, be of a known kind and not be expired
+func (payload *Payload) Valid() error {
+	if payload.Username == "" || !util.IsSupportedRole(payload.Role) {
+		return ErrInvalidToken
+	}
+	if payload.Kind != KindAccess && payload.Kind != KindRefresh {
+		return ErrInvalidToken
+	}
+	if time.Now().After(payload.ExpiredAt) {
+		return ErrExpiredToken
+	}
+	return nil
+}
+
+// checkKind checks that a valid token payload is of the kind expected by its verifier
+func (payload *Payload) checkKind(kind string) error {
+	if payload.Kind != kind {
+		return ErrWrongTokenKind
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package token
+
+import (
+	"testing"
+	"time"
+
+	"github.com/STAMBOULI-ABDELKARIM/car_repair_shop/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayloadValid(t *testing.T) {
+	testCases := []struct {
+		name    string
+		payload *Payload
+		err     error
+	}{
+		{"OK", NewPayload(util.RandomName(), util.RoleFrontDesk, KindAccess, time.Minute), nil},
+		{"Expired", NewPayload(util.RandomName(), util.RoleFrontDesk, KindAccess, -time.Minute), ErrExpiredToken},
+		{"NoUsername", NewPayload("", util.RoleFrontDesk, KindAccess, time.Minute), ErrInvalidToken},
+		{"NoRole", NewPayload(util.RandomName(), "", KindAccess, time.Minute), ErrInvalidToken},
+		{"UnsupportedRole", NewPayload(util.RandomName(), "owner", KindAccess, time.Minute), ErrInvalidToken},
+		{"Refresh", NewPayload(util.RandomName(), util.RoleFrontDesk, KindRefresh, time.Minute), nil},
+		{"NoKind", NewPayload(util.RandomName(), util.RoleFrontDesk, "", time.Minute), ErrInvalidToken},
+		{"UnknownKind", NewPayload(util.RandomName(), util.RoleFrontDesk, "id", time.Minute), ErrInvalidToken},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.err, tc.payload.Valid())
+		})
+	}
+}
+
+func TestNewMaker(t *testing.T) {
+	key := util.RandomString(32)
+
+	maker, err := NewMaker(TypeJWT, key)
+	require.NoError(t, err)
+	require.IsType(t, &JWTMaker{}, maker)
+
+	maker, err = NewMaker(TypePaseto, key)
+	require.NoError(t, err)
+	require.IsType(t, &PasetoMaker{}, maker)
+
+	maker, err = NewMaker("cookie", key)
+	require.Error(t, err)
+	require.Nil(t, maker)
+}
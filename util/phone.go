@@ -0,0 +1,26 @@
+package util
+
+import (
+	"fmt"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+// NormalizePhone parses a phone number and formats it to E.164, a number written without
+// its country code is read as a number of the default region, an ISO 3166 code like DZ
+func NormalizePhone(phone string, defaultRegion string) (string, error) {
+	number, err := phonenumbers.Parse(phone, defaultRegion)
+	if err != nil {
+		return "", fmt.Errorf("invalid phone number %q: %w", phone, err)
+	}
+	if !phonenumbers.IsValidNumber(number) {
+		return "", fmt.Errorf("invalid phone number %q", phone)
+	}
+	return phonenumbers.Format(number, phonenumbers.E164), nil
+}
+
+// IsSupportedPhoneRegion returns true if phone numbers of the region, an ISO 3166 code like DZ, can be parsed
+func IsSupportedPhoneRegion(region string) bool {
+	_, ok := phonenumbers.GetSupportedRegions()[region]
+	return ok
+}
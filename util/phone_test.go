@@ -0,0 +1,41 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizePhone(t *testing.T) {
+	testCases := []struct {
+		name   string
+		phone  string
+		region string
+		want   string
+		valid  bool
+	}{
+		{"E164", "+213550123456", "DZ", "+213550123456", true},
+		{"International", "+213 550 12 34 56", "DZ", "+213550123456", true},
+		{"National", "0550 12 34 56", "DZ", "+213550123456", true},
+		{"Dashes", "0550-12-34-56", "DZ", "+213550123456", true},
+		{"OtherRegion", "+33 6 12 34 56 78", "DZ", "+33612345678", true},
+		{"NationalOtherRegion", "06 12 34 56 78", "FR", "+33612345678", true},
+		{"TooShort", "0550", "DZ", "", false},
+		{"Letters", "phone", "DZ", "", false},
+		{"Empty", "", "DZ", "", false},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			phone, err := NormalizePhone(tc.phone, tc.region)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, phone)
+		})
+	}
+}
@@ -37,13 +37,28 @@ func RandomName() string {
 	return RandomString(6)
 }
 
-// RandomPhone generates a random Phone number
+// RandomPhone generates a random mobile Phone number of Algeria in E.164
 func RandomPhone() string {
-	//s := strconv.Itoa(RandomInt(0, 1000))
-	return strconv.Itoa(int(RandomInt(0, 1000)))
+	return "+21355" + strconv.Itoa(int(RandomInt(1000000, 9999999)))
 }
 
 // RandomEmail generates a random email
 func RandomEmail() string {
 	return fmt.Sprintf("%s@email.com", RandomString(6))
 }
+
+// RandomRegistration generates a random car registration number
+func RandomRegistration() string {
+	return fmt.Sprintf("%05d-%03d-%02d", RandomInt(0, 99999), RandomInt(100, 199), RandomInt(1, 58))
+}
+
+// RandomEnergy generates a random car energy type
+func RandomEnergy() string {
+	energies := []string{"diesel", "gasoline", "lpg", "electric", "hybrid"}
+	return energies[rand.Intn(len(energies))]
+}
+
+// RandomPrice generates a random price with two decimals
+func RandomPrice() string {
+	return fmt.Sprintf("%d.%02d", RandomInt(100, 10000), RandomInt(0, 99))
+}
@@ -0,0 +1,18 @@
+package util
+
+// The roles of the staff using the API
+const (
+	RoleAdmin      = "admin"
+	RoleFrontDesk  = "front_desk"
+	RoleMechanic   = "mechanic"
+	RoleAccountant = "accountant"
+)
+
+// IsSupportedRole returns true if the role is supported
+func IsSupportedRole(role string) bool {
+	switch role {
+	case RoleAdmin, RoleFrontDesk, RoleMechanic, RoleAccountant:
+		return true
+	}
+	return false
+}